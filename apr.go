@@ -0,0 +1,103 @@
+package sdk
+
+import (
+	"github.com/irisnet/irishub-sdk-go/modules/staking"
+	"github.com/irisnet/irishub-sdk-go/types"
+)
+
+// validatorsPageSize is the page size of the bonded validators queried by EstimateStakingAPR
+const validatorsPageSize = 100
+
+// StakingAPR is the estimated annual percentage rate of the staking rewards
+type StakingAPR struct {
+	Inflation        types.Dec `json:"inflation"`
+	AnnualProvisions types.Dec `json:"annual_provisions"`
+	BondedTokens     types.Int `json:"bonded_tokens"`
+	CommunityTax     types.Dec `json:"community_tax"`
+	// APR is the rate of the rewards of the bonded tokens before the commission of the validators
+	APR        types.Dec      `json:"apr"`
+	Validators []ValidatorAPR `json:"validators"`
+}
+
+// ValidatorAPR is the estimated rate of the rewards of the delegators of a bonded validator
+type ValidatorAPR struct {
+	OperatorAddress string    `json:"operator_address"`
+	Moniker         string    `json:"moniker"`
+	Commission      types.Dec `json:"commission"`
+	APR             types.Dec `json:"apr"`
+}
+
+// EstimateStakingAPR estimates the APR of the delegations of every bonded validator from the current
+// annual provisions (the inflation of the inflation base of the minter), bonded tokens, community tax and
+// commission rates. The proposer rewards, the missed blocks and the changes of the inflation over the
+// year are not taken into account.
+func (client *IRISHUBClient) EstimateStakingAPR() (StakingAPR, types.Error) {
+	inflation, err := client.Mint.QueryInflation()
+	if err != nil {
+		return StakingAPR{}, err
+	}
+
+	annualProvisions, err := client.Mint.QueryAnnualProvisions()
+	if err != nil {
+		return StakingAPR{}, err
+	}
+
+	pool, err := client.Staking.QueryPool()
+	if err != nil {
+		return StakingAPR{}, err
+	}
+
+	distrParams, err := client.Distribution.QueryParams()
+	if err != nil {
+		return StakingAPR{}, err
+	}
+
+	var validators []staking.QueryValidatorResp
+	for page := uint64(1); ; page++ {
+		res, err := client.Staking.QueryValidators(staking.Bonded.String(), page, validatorsPageSize)
+		if err != nil {
+			return StakingAPR{}, err
+		}
+		validators = append(validators, res.Validators...)
+		if len(res.Validators) < validatorsPageSize || uint64(len(validators)) >= res.Total {
+			break
+		}
+	}
+
+	return estimateStakingAPR(inflation, annualProvisions, pool.BondedTokens, distrParams.CommunityTax, validators), nil
+}
+
+// estimateStakingAPR returns annualProvisions * (1 - communityTax) / bondedTokens as the APR of
+// the bonded tokens, and APR * (1 - commission) as the APR of the delegations of each validator
+func estimateStakingAPR(inflation, annualProvisions types.Dec, bondedTokens types.Int,
+	communityTax types.Dec, validators []staking.QueryValidatorResp) StakingAPR {
+	apr := types.ZeroDec()
+	if bondedTokens.IsPositive() {
+		apr = annualProvisions.
+			Mul(types.OneDec().Sub(communityTax)).
+			Quo(types.NewDecFromInt(bondedTokens))
+	}
+
+	validatorAPRs := make([]ValidatorAPR, 0, len(validators))
+	for _, v := range validators {
+		commission := v.Commission.Rate
+		if commission.IsNil() {
+			commission = types.ZeroDec()
+		}
+		validatorAPRs = append(validatorAPRs, ValidatorAPR{
+			OperatorAddress: v.OperatorAddress,
+			Moniker:         v.Description.Moniker,
+			Commission:      commission,
+			APR:             apr.Mul(types.OneDec().Sub(commission)),
+		})
+	}
+
+	return StakingAPR{
+		Inflation:        inflation,
+		AnnualProvisions: annualProvisions,
+		BondedTokens:     bondedTokens,
+		CommunityTax:     communityTax,
+		APR:              apr,
+		Validators:       validatorAPRs,
+	}
+}
@@ -0,0 +1,35 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/irisnet/irishub-sdk-go/modules/staking"
+	"github.com/irisnet/irishub-sdk-go/types"
+)
+
+func TestEstimateStakingAPR(t *testing.T) {
+	validators := make([]staking.QueryValidatorResp, 3)
+	validators[0].Commission.Rate = types.NewDecWithPrec(1, 1)
+	validators[1].Commission.Rate = types.OneDec()
+
+	// 4% inflation of 1000 tokens, half of them bonded and 2% of the rewards to the community pool
+	apr := estimateStakingAPR(
+		types.NewDecWithPrec(4, 2),
+		types.NewDec(40),
+		types.NewInt(500),
+		types.NewDecWithPrec(2, 2),
+		validators,
+	)
+
+	require.Equal(t, types.MustNewDecFromStr("0.0784"), apr.APR)
+	require.Len(t, apr.Validators, 3)
+	require.Equal(t, types.MustNewDecFromStr("0.07056"), apr.Validators[0].APR)
+	require.True(t, apr.Validators[1].APR.IsZero())
+	require.Equal(t, apr.APR, apr.Validators[2].APR)
+	require.True(t, apr.Validators[2].Commission.IsZero())
+
+	apr = estimateStakingAPR(types.ZeroDec(), types.ZeroDec(), types.ZeroInt(), types.ZeroDec(), validators)
+	require.True(t, apr.APR.IsZero())
+}
@@ -2,13 +2,20 @@ package sdk
 
 import (
 	"fmt"
+	"github.com/irisnet/irishub-sdk-go/modules/auth"
+	"github.com/irisnet/irishub-sdk-go/modules/coinswap"
+	"github.com/irisnet/irishub-sdk-go/modules/distribution"
 	"github.com/irisnet/irishub-sdk-go/modules/gov"
 	"github.com/irisnet/irishub-sdk-go/modules/htlc"
+	"github.com/irisnet/irishub-sdk-go/modules/mint"
 	"github.com/irisnet/irishub-sdk-go/modules/nft"
 	"github.com/irisnet/irishub-sdk-go/modules/oracle"
+	"github.com/irisnet/irishub-sdk-go/modules/params"
 	"github.com/irisnet/irishub-sdk-go/modules/random"
 	"github.com/irisnet/irishub-sdk-go/modules/record"
+	"github.com/irisnet/irishub-sdk-go/modules/slashing"
 	"github.com/irisnet/irishub-sdk-go/modules/staking"
+	"github.com/irisnet/irishub-sdk-go/modules/upgrade"
 
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -20,6 +27,7 @@ import (
 	"github.com/irisnet/irishub-sdk-go/modules/keys"
 	"github.com/irisnet/irishub-sdk-go/modules/service"
 	"github.com/irisnet/irishub-sdk-go/modules/token"
+	"github.com/irisnet/irishub-sdk-go/modules/transfer"
 	"github.com/irisnet/irishub-sdk-go/types"
 	txtypes "github.com/irisnet/irishub-sdk-go/types/tx"
 )
@@ -30,17 +38,24 @@ type IRISHUBClient struct {
 	encodingConfig types.EncodingConfig
 
 	types.BaseClient
-	Key     keys.Client
-	Bank    bank.Client
-	Token   token.Client
-	Staking staking.Client
-	Gov     gov.Client
-	Service service.Client
-	Record  record.Client
-	Random  random.Client
-	NFT     nft.Client
-	Oracle  oracle.Client
-	HTLC    htlc.Client
+	Key          keys.Client
+	Auth         auth.Client
+	Bank         bank.Client
+	Token        token.Client
+	Staking      staking.Client
+	Distribution distribution.Client
+	Slashing     slashing.Client
+	Gov          gov.Client
+	Service      service.Client
+	Record       record.Client
+	Random       random.Client
+	NFT          nft.Client
+	Oracle       oracle.Client
+	HTLC         htlc.Client
+	Coinswap     coinswap.Client
+	Upgrade      upgrade.Client
+	Mint         mint.Client
+	Transfer     transfer.Client
 }
 
 func NewIRISHUBClient(cfg types.ClientConfig) IRISHUBClient {
@@ -50,9 +65,12 @@ func NewIRISHUBClient(cfg types.ClientConfig) IRISHUBClient {
 	baseClient := modules.NewBaseClient(cfg, encodingConfig, nil)
 	keysClient := keys.NewClient(baseClient)
 
+	authClient := auth.NewClient(baseClient, encodingConfig.Marshaler)
 	bankClient := bank.NewClient(baseClient, encodingConfig.Marshaler)
 	tokenClient := token.NewClient(baseClient, encodingConfig.Marshaler)
 	stakingClient := staking.NewClient(baseClient, encodingConfig.Marshaler)
+	distributionClient := distribution.NewClient(baseClient, encodingConfig.Marshaler)
+	slashingClient := slashing.NewClient(baseClient, encodingConfig.Marshaler)
 	govClient := gov.NewClient(baseClient, encodingConfig.Marshaler)
 
 	serviceClient := service.NewClient(baseClient, encodingConfig.Marshaler)
@@ -61,6 +79,10 @@ func NewIRISHUBClient(cfg types.ClientConfig) IRISHUBClient {
 	randomClient := random.NewClient(baseClient, encodingConfig.Marshaler)
 	oracleClient := oracle.NewClient(baseClient, encodingConfig.Marshaler)
 	htlcClient := htlc.NewClient(baseClient, encodingConfig.Marshaler)
+	coinswapClient := coinswap.NewClient(baseClient, encodingConfig.Marshaler)
+	upgradeClient := upgrade.NewClient(baseClient, encodingConfig.Marshaler)
+	mintClient := mint.NewClient(baseClient, encodingConfig.Marshaler)
+	transferClient := transfer.NewClient(baseClient, encodingConfig.Marshaler)
 
 	client := &IRISHUBClient{
 		logger:         baseClient.Logger(),
@@ -68,9 +90,12 @@ func NewIRISHUBClient(cfg types.ClientConfig) IRISHUBClient {
 		moduleManager:  make(map[string]types.Module),
 		encodingConfig: encodingConfig,
 		Key:            keysClient,
+		Auth:           authClient,
 		Bank:           bankClient,
 		Token:          tokenClient,
 		Staking:        stakingClient,
+		Distribution:   distributionClient,
+		Slashing:       slashingClient,
 		Gov:            govClient,
 		Service:        serviceClient,
 		Record:         recordClient,
@@ -78,12 +103,19 @@ func NewIRISHUBClient(cfg types.ClientConfig) IRISHUBClient {
 		NFT:            nftClient,
 		Oracle:         oracleClient,
 		HTLC:           htlcClient,
+		Coinswap:       coinswapClient,
+		Upgrade:        upgradeClient,
+		Mint:           mintClient,
+		Transfer:       transferClient,
 	}
 
 	client.RegisterModule(
+		authClient,
 		bankClient,
 		tokenClient,
 		stakingClient,
+		distributionClient,
+		slashingClient,
 		govClient,
 		serviceClient,
 		recordClient,
@@ -91,6 +123,10 @@ func NewIRISHUBClient(cfg types.ClientConfig) IRISHUBClient {
 		randomClient,
 		oracleClient,
 		htlcClient,
+		coinswapClient,
+		upgradeClient,
+		mintClient,
+		transferClient,
 	)
 	return *client
 }
@@ -152,9 +188,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cryptocodec.RegisterCrypto(cdc)
 }
 
-// RegisterInterfaces registers the sdk message type.
+// RegisterInterfaces registers the sdk message type and the proposal contents of the modules without a client.
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterInterface("cosmos.v1beta1.Msg", (*types.Msg)(nil))
 	txtypes.RegisterInterfaces(registry)
 	cryptocodec.RegisterInterfaces(registry)
+	params.RegisterInterfaces(registry)
 }
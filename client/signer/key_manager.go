@@ -0,0 +1,150 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	cryptoamino "github.com/irisnet/irishub-sdk-go/crypto/codec"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/store"
+)
+
+const defaultTimeout = 10 * time.Second
+
+var _ sdk.KeyManager = keyManager{}
+
+// keyManager forwards Sign and Find to a remote signer, the private keys never leave the signer,
+// so the keys can not be created, imported or exported through it
+type keyManager struct {
+	client  SignerClient
+	timeout time.Duration
+}
+
+// NewKeyManager returns a KeyManager signing by the remote signer of the connection,
+// it can be used by the client with types.KeyManagerOption
+func NewKeyManager(conn grpc.ClientConnInterface) sdk.KeyManager {
+	return keyManager{
+		client:  NewSignerClient(conn),
+		timeout: defaultTimeout,
+	}
+}
+
+// DialKeyManager connects the remote signer at addr over TLS, since the requests carry the key passwords.
+// The default TLS configuration verifies the signer with the system roots, it can be replaced
+// by grpc.WithTransportCredentials, use DialInsecureKeyManager for a connection without TLS
+func DialKeyManager(addr string, opts ...grpc.DialOption) (sdk.KeyManager, error) {
+	creds := credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	return dialKeyManager(addr, append([]grpc.DialOption{grpc.WithTransportCredentials(creds)}, opts...)...)
+}
+
+// DialInsecureKeyManager connects the remote signer at addr without TLS, the passwords are sent in plaintext,
+// so it should only be used when the connection is otherwise protected, e.g. through a unix socket, or in tests
+func DialInsecureKeyManager(addr string, opts ...grpc.DialOption) (sdk.KeyManager, error) {
+	return dialKeyManager(addr, append([]grpc.DialOption{grpc.WithInsecure()}, opts...)...)
+}
+
+func dialKeyManager(addr string, opts ...grpc.DialOption) (sdk.KeyManager, error) {
+	conn, err := grpc.Dial(addr, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return NewKeyManager(conn), nil
+}
+
+func (k keyManager) Sign(name, password string, data []byte) ([]byte, crypto.PubKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), k.timeout)
+	defer cancel()
+
+	res, err := k.client.Sign(ctx, &SignRequest{
+		Name:     name,
+		Password: password,
+		Data:     data,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pubKey, err := cryptoamino.PubKeyFromBytes(res.PubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !pubKey.VerifySignature(data, res.Signature) {
+		return nil, nil, fmt.Errorf("invalid signature of %s returned by the remote signer", name)
+	}
+	return res.Signature, pubKey, nil
+}
+
+func (k keyManager) Find(name, password string) (crypto.PubKey, sdk.AccAddress, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), k.timeout)
+	defer cancel()
+
+	res, err := k.client.PubKey(ctx, &PubKeyRequest{
+		Name:     name,
+		Password: password,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pubKey, err := cryptoamino.PubKeyFromBytes(res.PubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !bytes.Equal(pubKey.Address(), res.Address) {
+		return nil, nil, fmt.Errorf("the address of %s does not match its public key", name)
+	}
+	return pubKey, sdk.AccAddress(res.Address), nil
+}
+
+func (k keyManager) Insert(string, string, ...sdk.KeyOption) (string, string, error) {
+	return "", "", errUnsupported("Insert")
+}
+
+func (k keyManager) Recover(string, string, string, ...sdk.KeyOption) (string, error) {
+	return "", errUnsupported("Recover")
+}
+
+func (k keyManager) Import(string, string, string) (string, error) {
+	return "", errUnsupported("Import")
+}
+
+func (k keyManager) ImportPubKey(string, string, crypto.PubKey) (string, error) {
+	return "", errUnsupported("ImportPubKey")
+}
+
+func (k keyManager) ImportMultisig(string, string, int, []crypto.PubKey) (string, error) {
+	return "", errUnsupported("ImportMultisig")
+}
+
+func (k keyManager) Export(string, string) (string, error) {
+	return "", errUnsupported("Export")
+}
+
+func (k keyManager) Delete(string, string) error {
+	return errUnsupported("Delete")
+}
+
+func (k keyManager) ChangePassword(string, string, string) error {
+	return errUnsupported("ChangePassword")
+}
+
+func (k keyManager) Info(string) (store.KeyInfo, error) {
+	return store.KeyInfo{}, errUnsupported("Info")
+}
+
+func (k keyManager) List() ([]store.KeyInfo, error) {
+	return nil, errUnsupported("List")
+}
+
+func errUnsupported(method string) error {
+	return fmt.Errorf("%s is not supported by the remote signer", method)
+}
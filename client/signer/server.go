@@ -0,0 +1,113 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/irisnet/irishub-sdk-go/crypto"
+	cryptoamino "github.com/irisnet/irishub-sdk-go/crypto/codec"
+	"github.com/irisnet/irishub-sdk-go/types/store"
+)
+
+var _ SignerServer = server{}
+
+// server is the reference signer serving the keys of a KeyDAO
+type server struct {
+	keyDAO store.KeyDAO
+}
+
+// NewServer returns a SignerServer signing by the local keys of the KeyDAO, it is registered by
+//
+//	signer.RegisterSignerServer(grpcServer, signer.NewServer(keyDAO))
+//
+// The passwords are checked by the KeyDAO, a key it returns without a password is refused since
+// any password would unlock it.
+func NewServer(keyDAO store.KeyDAO) SignerServer {
+	return server{keyDAO: keyDAO}
+}
+
+func (s server) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	km, err := s.keyManager(req.Name, req.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := km.Sign(req.Data)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &SignResponse{
+		Signature: signature,
+		PubKey:    cryptoamino.MarshalPubkey(km.ExportPubKey()),
+	}, nil
+}
+
+func (s server) PubKey(_ context.Context, req *PubKeyRequest) (*PubKeyResponse, error) {
+	km, err := s.keyManager(req.Name, req.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey := km.ExportPubKey()
+	return &PubKeyResponse{
+		PubKey:  cryptoamino.MarshalPubkey(pubKey),
+		Address: pubKey.Address(),
+	}, nil
+}
+
+// keyManager unlocks the local key by the password, a wrong password is detected by
+// comparing the decrypted private key with the public key of the key
+func (s server) keyManager(name, password string) (crypto.KeyManager, error) {
+	if len(name) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if !s.keyDAO.Has(name) {
+		return nil, status.Errorf(codes.NotFound, "name %s not exist", name)
+	}
+
+	metadata, err := s.keyDAO.ReadMetadata(name)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if metadata.Type != store.TypeLocal {
+		return nil, status.Errorf(codes.FailedPrecondition, "the %s key %s can not sign", metadata.Type, name)
+	}
+
+	// the private key must not be usable without the password
+	if info, err := s.keyDAO.Read(name, ""); err == nil && s.unlock(info) != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "the key %s is not protected by a password", name)
+	}
+
+	info, err := s.keyDAO.Read(name, password)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	km := s.unlock(info)
+	if km == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid password of %s", name)
+	}
+	return km, nil
+}
+
+// unlock returns the key manager of the private key of the info, nil if the private key
+// can not be read or does not match the public key
+func (s server) unlock(info store.KeyInfo) crypto.KeyManager {
+	if len(info.PrivKeyArmor) == 0 {
+		return nil
+	}
+
+	km, err := crypto.NewPrivateKeyManager([]byte(info.PrivKeyArmor), info.Algo)
+	if err != nil {
+		return nil
+	}
+
+	if len(info.PubKey) > 0 && !bytes.Equal(cryptoamino.MarshalPubkey(km.ExportPubKey()), info.PubKey) {
+		return nil
+	}
+	return km
+}
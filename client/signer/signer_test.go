@@ -0,0 +1,190 @@
+package signer_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	sdk "github.com/irisnet/irishub-sdk-go"
+	"github.com/irisnet/irishub-sdk-go/client/signer"
+	"github.com/irisnet/irishub-sdk-go/testutil/simchain"
+	"github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/store"
+)
+
+const (
+	name     = "alice"
+	password = "12345678"
+	to       = "iaa1qzds87rxyrv4ak9gr2mx9ptjhsqs5thcha0e5n"
+)
+
+func TestRemoteSigner(t *testing.T) {
+	keyDAO, err := store.NewLevelDB(t.TempDir(), nil)
+	require.NoError(t, err)
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	signer.RegisterSignerServer(server, signer.NewServer(keyDAO))
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+	)
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	chain, err := simchain.New()
+	require.NoError(t, err)
+	defer func() { _ = chain.Close() }()
+
+	// the key is created by the signing service
+	cfg, err := chain.ClientConfig(types.KeyDAOOption(keyDAO))
+	require.NoError(t, err)
+	from, _, err := sdk.NewIRISHUBClient(cfg).Key.Add(name, password)
+	require.NoError(t, err)
+	require.NoError(t, chain.Fund(from, types.NewInt64Coin("uiris", 100000000)))
+
+	cfg, err = chain.ClientConfig(
+		types.KeyManagerOption(signer.NewKeyManager(conn)),
+		types.ModeOption(types.Commit),
+	)
+	require.NoError(t, err)
+	require.Nil(t, cfg.KeyDAO)
+	client := sdk.NewIRISHUBClient(cfg)
+
+	address, err := client.Key.Show(name, password)
+	require.NoError(t, err)
+	require.Equal(t, from, address)
+
+	amount, err := types.ParseDecCoins("10iris")
+	require.NoError(t, err)
+	_, err = client.Bank.Send(to, amount, types.BaseTx{From: name, Password: password})
+	require.NoError(t, err)
+	require.Equal(t, "10000000uiris", chain.Balances(to).String())
+
+	_, err = client.Key.Show(name, "87654321")
+	require.Error(t, err)
+
+	_, _, err = client.Key.Add("bob", password)
+	require.Error(t, err)
+
+	signerClient := signer.NewSignerClient(conn)
+	_, err = signerClient.Sign(context.Background(), &signer.SignRequest{Name: "bob", Password: password})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = signerClient.Sign(context.Background(), &signer.SignRequest{Name: name, Password: "87654321"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = signerClient.PubKey(context.Background(), &signer.PubKeyRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// a watch-only key can not sign whatever the password
+	info, err := keyDAO.ReadMetadata(name)
+	require.NoError(t, err)
+	require.NoError(t, keyDAO.Write("carol", "", store.KeyInfo{Name: "carol", PubKey: info.PubKey, Algo: info.Algo, Type: store.TypeOffline}))
+	_, err = signerClient.Sign(context.Background(), &signer.SignRequest{Name: "carol", Password: password})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+// plainCrypto keeps the private keys in plaintext, any password unlocks them
+type plainCrypto struct{}
+
+func (plainCrypto) Encrypt(data string, _ string) (string, error) { return data, nil }
+func (plainCrypto) Decrypt(data string, _ string) (string, error) { return data, nil }
+
+func TestServerUnprotectedKey(t *testing.T) {
+	chain, err := simchain.New()
+	require.NoError(t, err)
+	defer func() { _ = chain.Close() }()
+
+	keyDAO := store.NewMemory(plainCrypto{})
+	cfg, err := chain.ClientConfig(types.KeyDAOOption(keyDAO))
+	require.NoError(t, err)
+	_, _, err = sdk.NewIRISHUBClient(cfg).Key.Add(name, password)
+	require.NoError(t, err)
+
+	// the server refuses the key instead of accepting any password
+	_, err = signer.NewServer(keyDAO).Sign(context.Background(), &signer.SignRequest{Name: name, Password: "87654321"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestDialKeyManager(t *testing.T) {
+	cert, pool := selfSignedCert(t)
+
+	serve := func(opts ...grpc.ServerOption) string {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		server := grpc.NewServer(opts...)
+		signer.RegisterSignerServer(server, signer.NewServer(store.NewMemory(nil)))
+		go func() { _ = server.Serve(listener) }()
+		t.Cleanup(server.Stop)
+		return listener.Addr().String()
+	}
+	tlsAddr := serve(grpc.Creds(credentials.NewServerTLSFromCert(&cert)))
+	plainAddr := serve()
+
+	// a reachable signer answers that the key does not exist
+	find := func(km types.KeyManager) codes.Code {
+		_, _, err := km.Find(name, password)
+		return status.Code(err)
+	}
+
+	km, err := signer.DialKeyManager(tlsAddr, grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(pool, "")))
+	require.NoError(t, err)
+	require.Equal(t, codes.NotFound, find(km))
+
+	// the certificate of the signer is verified with the system roots by default
+	km, err = signer.DialKeyManager(tlsAddr)
+	require.NoError(t, err)
+	require.Equal(t, codes.Unavailable, find(km))
+
+	// the passwords are never sent to a signer without TLS unless it is dialed explicitly as insecure
+	km, err = signer.DialKeyManager(plainAddr)
+	require.NoError(t, err)
+	require.Equal(t, codes.Unavailable, find(km))
+
+	km, err = signer.DialInsecureKeyManager(plainAddr)
+	require.NoError(t, err)
+	require.Equal(t, codes.NotFound, find(km))
+}
+
+// selfSignedCert returns a certificate of 127.0.0.1 and the pool trusting it
+func selfSignedCert(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "signer"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(leaf)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}, pool
+}
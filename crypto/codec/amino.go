@@ -6,9 +6,11 @@ import (
 	"github.com/tendermint/tendermint/crypto/sr25519"
 
 	"github.com/irisnet/irishub-sdk-go/codec"
+	codectypes "github.com/irisnet/irishub-sdk-go/codec/types"
 	"github.com/irisnet/irishub-sdk-go/crypto/keys/ed25519"
 	kmultisig "github.com/irisnet/irishub-sdk-go/crypto/keys/multisig"
 	"github.com/irisnet/irishub-sdk-go/crypto/keys/secp256k1"
+	"github.com/irisnet/irishub-sdk-go/crypto/keys/sm2"
 	cryptotypes "github.com/irisnet/irishub-sdk-go/crypto/types"
 )
 
@@ -28,6 +30,7 @@ func RegisterCrypto(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(tmed25519.PubKey{}, tmed25519.PubKeyName, nil)
 	cdc.RegisterConcrete(&ed25519.PubKey{}, ed25519.PubKeyName, nil)
 	cdc.RegisterConcrete(&secp256k1.PubKey{}, secp256k1.PubKeyName, nil)
+	cdc.RegisterConcrete(&sm2.PubKey{}, sm2.PubKeyName, nil)
 	cdc.RegisterConcrete(&kmultisig.LegacyAminoPubKey{}, kmultisig.PubKeyAminoRoute, nil)
 
 	cdc.RegisterInterface((*crypto.PrivKey)(nil), nil)
@@ -36,6 +39,7 @@ func RegisterCrypto(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(tmed25519.PrivKey{}, tmed25519.PrivKeyName, nil)
 	cdc.RegisterConcrete(&ed25519.PrivKey{}, ed25519.PrivKeyName, nil)
 	cdc.RegisterConcrete(&secp256k1.PrivKey{}, secp256k1.PrivKeyName, nil)
+	cdc.RegisterConcrete(&sm2.PrivKey{}, sm2.PrivKeyName, nil)
 }
 
 // PrivKeyFromBytes unmarshals private key bytes and returns a PrivKey
@@ -46,7 +50,11 @@ func PrivKeyFromBytes(privKeyBytes []byte) (privKey crypto.PrivKey, err error) {
 
 // PubKeyFromBytes unmarshals public key bytes and returns a PubKey
 func PubKeyFromBytes(pubKeyBytes []byte) (pubKey crypto.PubKey, err error) {
-	err = amino.UnmarshalBinaryBare(pubKeyBytes, &pubKey)
+	if err = amino.UnmarshalBinaryBare(pubKeyBytes, &pubKey); err != nil {
+		return
+	}
+	// the public keys nested in a multisig public key are unpacked by the concrete type
+	err = codectypes.UnpackInterfaces(pubKey, codectypes.AminoUnpacker{Cdc: amino.Amino})
 	return
 }
 
@@ -2,11 +2,16 @@ package hd
 
 import (
 	"fmt"
+	"math/big"
 
 	bip39 "github.com/cosmos/go-bip39"
 	"github.com/tendermint/tendermint/crypto"
+	gmsm2 "github.com/tjfoc/gmsm/sm2"
+	"golang.org/x/crypto/ed25519"
 
+	cryptoed25519 "github.com/irisnet/irishub-sdk-go/crypto/keys/ed25519"
 	"github.com/irisnet/irishub-sdk-go/crypto/keys/secp256k1"
+	"github.com/irisnet/irishub-sdk-go/crypto/keys/sm2"
 )
 
 type SignatureAlgo interface {
@@ -15,10 +20,15 @@ type SignatureAlgo interface {
 	Generate() GenerateFn
 }
 
+// NewSigningAlgoFromString returns the signing algorithm named str: secp256k1, sm2 or ed25519
 func NewSigningAlgoFromString(str string) (SignatureAlgo, error) {
 	switch str {
 	case string(Secp256k1.Name()):
 		return Secp256k1, nil
+	case string(Sm2.Name()):
+		return Sm2, nil
+	case string(Ed25519.Name()):
+		return Ed25519, nil
 	default:
 		return nil, fmt.Errorf("provided algorithm `%s` is not supported", str)
 	}
@@ -33,8 +43,9 @@ const (
 	// Secp256k1Type uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1Type = PubKeyType("secp256k1")
 	// Ed25519Type represents the Ed25519Type signature system.
-	// It is currently not supported for end-user keys (wallets/ledgers).
 	Ed25519Type = PubKeyType("ed25519")
+	// Sm2Type represents the SM2 signature system of GM/T 0003.
+	Sm2Type = PubKeyType("sm2")
 	// Sr25519Type represents the Sr25519Type signature system.
 	Sr25519Type = PubKeyType("sr25519")
 )
@@ -42,6 +53,10 @@ const (
 var (
 	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1 = secp256k1Algo{}
+	// Sm2 uses the SM2 elliptic curve of GM/T 0003.
+	Sm2 = sm2Algo{}
+	// Ed25519 uses the Ed25519 signature system of RFC 8032.
+	Ed25519 = ed25519Algo{}
 )
 
 type DeriveFn func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error)
@@ -61,28 +76,99 @@ func (s secp256k1Algo) Name() PubKeyType {
 
 // Derive derives and returns the secp256k1 private key for the given seed and HD path.
 func (s secp256k1Algo) Derive() DeriveFn {
+	return deriveBIP32
+}
+
+// Generate generates a secp256k1 private key from the given bytes.
+func (s secp256k1Algo) Generate() GenerateFn {
+	return func(bz []byte) crypto.PrivKey {
+		var bzArr = make([]byte, secp256k1.PrivKeySize)
+		copy(bzArr, bz)
+
+		return &secp256k1.PrivKey{Key: bzArr}
+	}
+}
+
+type sm2Algo struct {
+}
+
+func (s sm2Algo) Name() PubKeyType {
+	return Sm2Type
+}
+
+// Derive derives and returns the sm2 private key for the given seed and HD path.
+// The BIP32 derivation yields scalars of secp256k1, the ones out of the range [1, N)
+// of the sm2 curve are rejected rather than used as an invalid sm2 private key.
+func (s sm2Algo) Derive() DeriveFn {
 	return func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error) {
-		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+		bz, err := deriveBIP32(mnemonic, bip39Passphrase, hdPath)
 		if err != nil {
 			return nil, err
 		}
-
-		masterPriv, ch := ComputeMastersFromSeed(seed)
-		if len(hdPath) == 0 {
-			return masterPriv[:], nil
+		if err := checkSm2Key(bz); err != nil {
+			return nil, err
 		}
-		derivedKey, err := DerivePrivateKeyForPath(masterPriv, ch, hdPath)
-
-		return derivedKey, err
+		return bz, nil
 	}
 }
 
-// Generate generates a secp256k1 private key from the given bytes.
-func (s secp256k1Algo) Generate() GenerateFn {
+// Generate generates a sm2 private key from the bytes returned by Derive.
+func (s sm2Algo) Generate() GenerateFn {
 	return func(bz []byte) crypto.PrivKey {
-		var bzArr = make([]byte, secp256k1.PrivKeySize)
+		var bzArr = make([]byte, sm2.PrivKeySize)
 		copy(bzArr, bz)
 
-		return &secp256k1.PrivKey{Key: bzArr}
+		return &sm2.PrivKey{Key: bzArr}
+	}
+}
+
+type ed25519Algo struct {
+}
+
+func (s ed25519Algo) Name() PubKeyType {
+	return Ed25519Type
+}
+
+// Derive derives and returns the ed25519 seed for the given seed and HD path.
+// The seed is derived with the BIP32 derivation of secp256k1 and not with SLIP-10,
+// so the derivation is specific to this SDK and the keys differ from the ones
+// derived from the same mnemonic by SLIP-10 wallets.
+func (s ed25519Algo) Derive() DeriveFn {
+	return deriveBIP32
+}
+
+// Generate generates a ed25519 private key from the given seed bytes.
+func (s ed25519Algo) Generate() GenerateFn {
+	return func(bz []byte) crypto.PrivKey {
+		var seed = make([]byte, ed25519.SeedSize)
+		copy(seed, bz)
+
+		return &cryptoed25519.PrivKey{Key: ed25519.NewKeyFromSeed(seed)}
+	}
+}
+
+// deriveBIP32 derives the private key bytes for the given seed and HD path
+// with the BIP32 derivation of secp256k1, which is shared by all algorithms.
+func deriveBIP32(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	masterPriv, ch := ComputeMastersFromSeed(seed)
+	if len(hdPath) == 0 {
+		return masterPriv[:], nil
+	}
+	derivedKey, err := DerivePrivateKeyForPath(masterPriv, ch, hdPath)
+
+	return derivedKey, err
+}
+
+// checkSm2Key checks that bz is a valid sm2 private key, i.e. 0 < k < N.
+func checkSm2Key(bz []byte) error {
+	k := new(big.Int).SetBytes(bz)
+	if k.Sign() <= 0 || k.Cmp(gmsm2.P256Sm2().Params().N) >= 0 {
+		return fmt.Errorf("derived key is out of the range of the sm2 curve order, use another hd path")
 	}
+	return nil
 }
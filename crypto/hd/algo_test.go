@@ -0,0 +1,32 @@
+package hd
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gmsm2 "github.com/tjfoc/gmsm/sm2"
+)
+
+func TestCheckSm2Key(t *testing.T) {
+	n := gmsm2.P256Sm2().Params().N
+
+	require.NoError(t, checkSm2Key(big.NewInt(1).Bytes()))
+	require.NoError(t, checkSm2Key(new(big.Int).Sub(n, big.NewInt(1)).Bytes()))
+
+	require.Error(t, checkSm2Key(make([]byte, 32)))
+	require.Error(t, checkSm2Key(n.Bytes()))
+	require.Error(t, checkSm2Key(new(big.Int).Add(n, big.NewInt(1)).Bytes()))
+}
+
+func TestSm2Derive(t *testing.T) {
+	mnemonic := "nerve leader thank marriage spice task van start piece crowd run hospital control outside cousin romance left choice poet wagon rude climb leisure spring"
+
+	bz, err := Sm2.Derive()(mnemonic, "", FullPath)
+	require.NoError(t, err)
+	require.NoError(t, checkSm2Key(bz))
+
+	expected, err := Secp256k1.Derive()(mnemonic, "", FullPath)
+	require.NoError(t, err)
+	require.Equal(t, expected, bz)
+}
@@ -13,6 +13,8 @@ import (
 )
 
 const (
+	// CoinType is the BIP44 coin type of the keys, it is the same as cosmos
+	CoinType    = 118
 	BIP44Prefix = "44'/118'/"
 	PartialPath = "0'/0/0"
 	FullPath    = BIP44Prefix + PartialPath
@@ -38,6 +38,11 @@ func NewKeyManager() KeyManager {
 }
 
 func NewAlgoKeyManager(algo string) (KeyManager, error) {
+	return NewAlgoKeyManagerWithHDPath(algo, hd.FullPath, defaultBIP39Passphrase)
+}
+
+// NewAlgoKeyManagerWithHDPath generates a new mnemonic and derives the key at hdPath with the BIP39 passphrase
+func NewAlgoKeyManagerWithHDPath(algo, hdPath, bip39Passphrase string) (KeyManager, error) {
 	entropy, err := bip39.NewEntropy(256)
 	if err != nil {
 		return nil, err
@@ -46,15 +51,20 @@ func NewAlgoKeyManager(algo string) (KeyManager, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewMnemonicKeyManager(mnemonic, algo)
+	return NewMnemonicKeyManagerWithHDPath(mnemonic, algo, hdPath, bip39Passphrase)
 }
 
 func NewMnemonicKeyManager(mnemonic string, algo string) (KeyManager, error) {
+	return NewMnemonicKeyManagerWithHDPath(mnemonic, algo, hd.FullPath, defaultBIP39Passphrase)
+}
+
+// NewMnemonicKeyManagerWithHDPath recovers the key at hdPath from the mnemonic and the BIP39 passphrase
+func NewMnemonicKeyManagerWithHDPath(mnemonic, algo, hdPath, bip39Passphrase string) (KeyManager, error) {
 	k := keyManager{
 		mnemonic: mnemonic,
 		algo:     algo,
 	}
-	err := k.recoveryFromMnemonic(mnemonic, hd.FullPath, algo)
+	err := k.recoveryFromMnemonic(mnemonic, hdPath, bip39Passphrase, algo)
 	return &k, err
 }
 
@@ -78,7 +88,7 @@ func (m *keyManager) Sign(data []byte) ([]byte, error) {
 	return m.privKey.Sign(data)
 }
 
-func (m *keyManager) recoveryFromMnemonic(mnemonic, hdPath, algoStr string) error {
+func (m *keyManager) recoveryFromMnemonic(mnemonic, hdPath, bip39Passphrase, algoStr string) error {
 	words := strings.Split(mnemonic, " ")
 	if len(words) != 12 && len(words) != 24 {
 		return fmt.Errorf("mnemonic length should either be 12 or 24")
@@ -90,7 +100,7 @@ func (m *keyManager) recoveryFromMnemonic(mnemonic, hdPath, algoStr string) erro
 	}
 
 	// create master key and derive first key for keyring
-	derivedPriv, err := algo.Derive()(mnemonic, defaultBIP39Passphrase, hdPath)
+	derivedPriv, err := algo.Derive()(mnemonic, bip39Passphrase, hdPath)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/irisnet/irishub-sdk-go/crypto"
+	"github.com/irisnet/irishub-sdk-go/crypto/hd"
 	sdk "github.com/irisnet/irishub-sdk-go/types"
 )
 
@@ -23,3 +24,55 @@ func TestNewMnemonicKeyManager(t *testing.T) {
 	address := sdk.AccAddress(pubKey.Address()).String()
 	assert.Equal(t, "iaa1y9kd9uy7a4qnjp0z5yjx5jhrkv2ycdkzqc0h8z", address)
 }
+
+func TestNewMnemonicKeyManagerWithAlgo(t *testing.T) {
+	mnemonic := "nerve leader thank marriage spice task van start piece crowd run hospital control outside cousin romance left choice poet wagon rude climb leisure spring"
+
+	for _, algo := range []string{"sm2", "ed25519"} {
+		km, err := crypto.NewMnemonicKeyManager(mnemonic, algo)
+		assert.NoError(t, err)
+
+		pubKey := km.ExportPubKey()
+		assert.Equal(t, algo, pubKey.Type())
+
+		signature, err := km.Sign([]byte("data"))
+		assert.NoError(t, err)
+		assert.True(t, pubKey.VerifySignature([]byte("data"), signature))
+
+		armor, err := km.ExportPrivKey("12345678")
+		assert.NoError(t, err)
+
+		privKey, importedAlgo, err := crypto.NewKeyManager().ImportPrivKey(armor, "12345678")
+		assert.NoError(t, err)
+		assert.Equal(t, algo, importedAlgo)
+		assert.True(t, pubKey.Equals(privKey.PubKey()))
+	}
+
+	_, err := crypto.NewMnemonicKeyManager(mnemonic, "rsa")
+	assert.Error(t, err)
+}
+
+func TestNewMnemonicKeyManagerWithHDPath(t *testing.T) {
+	mnemonic := "nerve leader thank marriage spice task van start piece crowd run hospital control outside cousin romance left choice poet wagon rude climb leisure spring"
+
+	km, err := crypto.NewMnemonicKeyManagerWithHDPath(mnemonic, "secp256k1", hd.FullPath, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "iaa1y9kd9uy7a4qnjp0z5yjx5jhrkv2ycdkzqc0h8z", sdk.AccAddress(km.ExportPubKey().Address()).String())
+
+	seen := map[string]bool{}
+	for _, path := range []*hd.BIP44Params{
+		hd.NewFundraiserParams(1, hd.CoinType, 0),
+		hd.NewFundraiserParams(0, hd.CoinType, 1),
+	} {
+		km, err := crypto.NewMnemonicKeyManagerWithHDPath(mnemonic, "secp256k1", path.String(), "")
+		assert.NoError(t, err)
+		seen[sdk.AccAddress(km.ExportPubKey().Address()).String()] = true
+	}
+
+	km, err = crypto.NewMnemonicKeyManagerWithHDPath(mnemonic, "secp256k1", hd.FullPath, "passphrase")
+	assert.NoError(t, err)
+	seen[sdk.AccAddress(km.ExportPubKey().Address()).String()] = true
+
+	assert.Len(t, seen, 3)
+	assert.False(t, seen["iaa1y9kd9uy7a4qnjp0z5yjx5jhrkv2ycdkzqc0h8z"])
+}
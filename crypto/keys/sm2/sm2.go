@@ -0,0 +1,258 @@
+package sm2
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tjfoc/gmsm/sm2"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	cryptotypes "github.com/irisnet/irishub-sdk-go/crypto/types"
+)
+
+//-------------------------------------
+
+const (
+	PrivKeyName = "tendermint/PrivKeySm2"
+	PubKeyName  = "tendermint/PubKeySm2"
+	// PrivKeySize is the size, in bytes, of private keys as used in this package.
+	PrivKeySize = 32
+	// PubKeySize is the size, in bytes, of the compressed public keys as used in this package.
+	PubKeySize = 33
+	// SignatureSize is the size, in bytes, of the r || s signatures as used in this package.
+	SignatureSize = 64
+
+	keyType = "sm2"
+)
+
+var _ cryptotypes.PrivKey = &PrivKey{}
+var _ codec.AminoMarshaler = &PrivKey{}
+
+// Bytes returns the privkey byte format.
+func (privKey *PrivKey) Bytes() []byte {
+	return privKey.Key
+}
+
+// Sign produces a signature on the provided message with the default user id of GM/T 0009.
+// The signature is the concatenation of r and s, both padded to 32 bytes.
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	r, s, err := sm2.Sm2Sign(privKey.privateKey(), msg, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	sig := make([]byte, SignatureSize)
+	rBytes, sBytes := r.Bytes(), s.Bytes()
+	copy(sig[32-len(rBytes):32], rBytes)
+	copy(sig[64-len(sBytes):], sBytes)
+	return sig, nil
+}
+
+// PubKey gets the corresponding compressed public key from the private key.
+func (privKey *PrivKey) PubKey() crypto.PubKey {
+	return &PubKey{Key: sm2.Compress(&privKey.privateKey().PublicKey)}
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey *PrivKey) Equals(other crypto.PrivKey) bool {
+	if privKey.Type() != other.Type() {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
+}
+
+func (privKey *PrivKey) Type() string {
+	return keyType
+}
+
+// MarshalAmino overrides Amino binary marshalling.
+func (privKey PrivKey) MarshalAmino() ([]byte, error) {
+	return privKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshalling.
+func (privKey *PrivKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PrivKeySize {
+		return fmt.Errorf("invalid privkey size")
+	}
+	privKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshalling.
+func (privKey PrivKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return privKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshalling.
+func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return privKey.UnmarshalAmino(bz)
+}
+
+func (privKey *PrivKey) privateKey() *sm2.PrivateKey {
+	curve := sm2.P256Sm2()
+	priv := new(sm2.PrivateKey)
+	priv.Curve = curve
+	priv.D = new(big.Int).SetBytes(privKey.Key)
+	priv.X, priv.Y = curve.ScalarBaseMult(privKey.Key)
+	return priv
+}
+
+// GenPrivKey generates a new sm2 private key.
+// It uses OS randomness to generate the private key.
+func GenPrivKey() *PrivKey {
+	return &PrivKey{Key: genPrivKey(crypto.CReader())}
+}
+
+// genPrivKey generates a new sm2 private key using the provided reader.
+func genPrivKey(rand io.Reader) []byte {
+	var privKeyBytes [PrivKeySize]byte
+	d := new(big.Int)
+	for {
+		privKeyBytes = [PrivKeySize]byte{}
+		_, err := io.ReadFull(rand, privKeyBytes[:])
+		if err != nil {
+			panic(err)
+		}
+
+		d.SetBytes(privKeyBytes[:])
+		// break if we found a valid point (i.e. > 0 and < N == curverOrder)
+		isValidFieldElement := 0 < d.Sign() && d.Cmp(sm2.P256Sm2().Params().N) < 0
+		if isValidFieldElement {
+			break
+		}
+	}
+
+	return privKeyBytes[:]
+}
+
+var one = new(big.Int).SetInt64(1)
+
+// GenPrivKeyFromSecret hashes the secret with SHA2, and uses
+// that 32 byte output to create the private key.
+//
+// It makes sure the private key is a valid field element by setting:
+//
+// c = sha256(secret)
+// k = (c mod (n − 1)) + 1, where n = curve order.
+//
+// NOTE: secret should be the output of a KDF like bcrypt,
+// if it's derived from user input.
+func GenPrivKeyFromSecret(secret []byte) *PrivKey {
+	secHash := sha256.Sum256(secret)
+	fe := new(big.Int).SetBytes(secHash[:])
+	n := new(big.Int).Sub(sm2.P256Sm2().Params().N, one)
+	fe.Mod(fe, n)
+	fe.Add(fe, one)
+
+	feB := fe.Bytes()
+	privKey32 := make([]byte, PrivKeySize)
+	// copy feB over to fixed 32 byte privKey32 and pad (if necessary)
+	copy(privKey32[32-len(feB):32], feB)
+
+	return &PrivKey{Key: privKey32}
+}
+
+//-------------------------------------
+
+var _ cryptotypes.PubKey = &PubKey{}
+var _ codec.AminoMarshaler = &PubKey{}
+
+// Address is the SHA256-20 of the compressed pubkey bytes.
+func (pubKey *PubKey) Address() crypto.Address {
+	if len(pubKey.Key) != PubKeySize {
+		panic("pubkey is incorrect size")
+	}
+	return crypto.Address(tmhash.SumTruncated(pubKey.Key))
+}
+
+// Bytes returns the PubKey byte format.
+func (pubKey *PubKey) Bytes() []byte {
+	return pubKey.Key
+}
+
+// VerifySignature verifies a r || s signature made with the default user id of GM/T 0009.
+func (pubKey *PubKey) VerifySignature(msg []byte, sig []byte) bool {
+	if len(sig) != SignatureSize {
+		return false
+	}
+
+	pub, ok := pubKey.publicKey()
+	if !ok {
+		return false
+	}
+
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	return sm2.Sm2Verify(pub, msg, nil, r, s)
+}
+
+func (pubKey *PubKey) String() string {
+	return fmt.Sprintf("PubKeySm2{%X}", pubKey.Key)
+}
+
+func (pubKey *PubKey) Type() string {
+	return keyType
+}
+
+func (pubKey *PubKey) Equals(other crypto.PubKey) bool {
+	if pubKey.Type() != other.Type() {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare(pubKey.Bytes(), other.Bytes()) == 1
+}
+
+// MarshalAmino overrides Amino binary marshalling.
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshalling.
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PubKeySize {
+		return fmt.Errorf("invalid pubkey size")
+	}
+	pubKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshalling.
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshalling.
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
+
+// publicKey decompresses the public key, it returns false if the key is not a point of the curve
+func (pubKey *PubKey) publicKey() (pub *sm2.PublicKey, ok bool) {
+	if len(pubKey.Key) != PubKeySize || (pubKey.Key[0] != 0x02 && pubKey.Key[0] != 0x03) {
+		return nil, false
+	}
+
+	// Decompress panics when the x-coordinate has no matching y-coordinate
+	defer func() {
+		if r := recover(); r != nil {
+			pub, ok = nil, false
+		}
+	}()
+
+	pub = sm2.Decompress(pubKey.Key)
+	return pub, pub.Curve.IsOnCurve(pub.X, pub.Y)
+}
@@ -0,0 +1,45 @@
+package sm2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/irisnet/irishub-sdk-go/crypto/keys/sm2"
+)
+
+func TestSignAndValidateSm2(t *testing.T) {
+	privKey := sm2.GenPrivKey()
+	pubKey := privKey.PubKey()
+	require.Len(t, pubKey.Bytes(), sm2.PubKeySize)
+
+	msg := crypto.CRandBytes(128)
+	sig, err := privKey.Sign(msg)
+	require.Nil(t, err)
+	require.Len(t, sig, sm2.SignatureSize)
+
+	// Test the signature
+	assert.True(t, pubKey.VerifySignature(msg, sig))
+
+	// Mutate the signature, just one bit.
+	sig[7] ^= byte(0x01)
+	assert.False(t, pubKey.VerifySignature(msg, sig))
+
+	// The signature does not verify with another key
+	sig[7] ^= byte(0x01)
+	assert.False(t, sm2.GenPrivKey().PubKey().VerifySignature(msg, sig))
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	privKey := sm2.GenPrivKeyFromSecret([]byte("secret"))
+	require.Equal(t, privKey, sm2.GenPrivKeyFromSecret([]byte("secret")))
+	require.True(t, privKey.PubKey().Equals(sm2.GenPrivKeyFromSecret([]byte("secret")).PubKey()))
+	require.Len(t, privKey.PubKey().Address(), 20)
+}
+
+func TestInvalidPubKey(t *testing.T) {
+	pubKey := &sm2.PubKey{Key: make([]byte, sm2.PubKeySize)}
+	require.False(t, pubKey.VerifySignature([]byte("msg"), make([]byte, sm2.SignatureSize)))
+}
@@ -0,0 +1,160 @@
+// Package shamir implements the Shamir's secret sharing over GF(2^8).
+//
+// Every byte of the secret is the constant term of a random polynomial of degree threshold-1,
+// a share is the evaluation of the polynomials at its x coordinate. The x coordinate is
+// appended to the evaluations, so a share is one byte longer than the secret, the same
+// layout as the shamir package of HashiCorp Vault.
+package shamir
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+const (
+	// ShareOverhead is the number of bytes a share is longer than the secret
+	ShareOverhead = 1
+
+	maxShares = 255
+)
+
+// Split splits the secret into n shares, any threshold of them can recover the secret
+func Split(secret []byte, n, threshold int) ([][]byte, error) {
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("secret can not be empty")
+	}
+	if n < threshold {
+		return nil, fmt.Errorf("shares(%d) can not be less than the threshold(%d)", n, threshold)
+	}
+	if n > maxShares {
+		return nil, fmt.Errorf("shares(%d) can not be more than %d", n, maxShares)
+	}
+	if threshold < 2 {
+		return nil, fmt.Errorf("threshold(%d) must be at least 2", threshold)
+	}
+
+	shares := make([][]byte, n)
+	for i := range shares {
+		shares[i] = make([]byte, len(secret)+ShareOverhead)
+		shares[i][len(secret)] = byte(i + 1)
+	}
+
+	coefficients := make([]byte, threshold)
+	for j, b := range secret {
+		coefficients[0] = b
+		if _, err := rand.Read(coefficients[1:]); err != nil {
+			return nil, err
+		}
+
+		for i := range shares {
+			shares[i][j] = evaluate(coefficients, byte(i+1))
+		}
+	}
+	return shares, nil
+}
+
+// Combine recovers the secret from the shares, the result is meaningless if the number
+// of shares is less than the threshold of the split
+func Combine(shares [][]byte) ([]byte, error) {
+	if len(shares) < 2 {
+		return nil, fmt.Errorf("at least 2 shares are required")
+	}
+
+	size := len(shares[0])
+	if size <= ShareOverhead {
+		return nil, fmt.Errorf("invalid share")
+	}
+
+	xs := make([]byte, len(shares))
+	seen := make(map[byte]bool, len(shares))
+	for i, share := range shares {
+		if len(share) != size {
+			return nil, fmt.Errorf("the shares have different lengths")
+		}
+
+		x := share[size-1]
+		if x == 0 || seen[x] {
+			return nil, fmt.Errorf("duplicate or invalid share %d", x)
+		}
+		seen[x] = true
+		xs[i] = x
+	}
+
+	secret := make([]byte, size-ShareOverhead)
+	ys := make([]byte, len(shares))
+	for j := range secret {
+		for i, share := range shares {
+			ys[i] = share[j]
+		}
+		secret[j] = interpolate(xs, ys)
+	}
+	return secret, nil
+}
+
+// evaluate returns the value of the polynomial at x by the Horner's method
+func evaluate(coefficients []byte, x byte) byte {
+	var result byte
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		result = add(mul(result, x), coefficients[i])
+	}
+	return result
+}
+
+// interpolate returns the value at 0 of the Lagrange polynomial of the points
+func interpolate(xs, ys []byte) byte {
+	var result byte
+	for i := range xs {
+		basis := byte(1)
+		for j := range xs {
+			if i == j {
+				continue
+			}
+			// (0 - x_j) / (x_i - x_j), the subtraction is the addition in GF(2^8)
+			basis = mul(basis, div(xs[j], add(xs[i], xs[j])))
+		}
+		result = add(result, mul(ys[i], basis))
+	}
+	return result
+}
+
+func add(a, b byte) byte {
+	return a ^ b
+}
+
+func mul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return expTable[(int(logTable[a])+int(logTable[b]))%255]
+}
+
+func div(a, b byte) byte {
+	if b == 0 {
+		panic("divide by zero")
+	}
+	if a == 0 {
+		return 0
+	}
+	return expTable[(int(logTable[a])-int(logTable[b])+255)%255]
+}
+
+// logTable and expTable are the logarithms and powers of the generator 3 in GF(2^8)
+// with the reducing polynomial x^8 + x^4 + x^3 + x + 1 of AES
+var logTable, expTable = func() ([256]byte, [255]byte) {
+	var logs [256]byte
+	var exps [255]byte
+
+	x := byte(1)
+	for i := 0; i < 255; i++ {
+		exps[i] = x
+		logs[x] = byte(i)
+
+		// x *= 3
+		x2 := x << 1
+		if x&0x80 != 0 {
+			x2 ^= 0x1b
+		}
+		x ^= x2
+	}
+	return logs, exps
+}()
@@ -0,0 +1,56 @@
+package shamir
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitCombine(t *testing.T) {
+	secret := []byte("the secret of the officers")
+
+	shares, err := Split(secret, 5, 3)
+	require.NoError(t, err)
+	require.Len(t, shares, 5)
+	for _, share := range shares {
+		require.Len(t, share, len(secret)+ShareOverhead)
+	}
+
+	// every 3 shares recover the secret
+	for i := 0; i < 5; i++ {
+		for j := i + 1; j < 5; j++ {
+			for k := j + 1; k < 5; k++ {
+				recovered, err := Combine([][]byte{shares[k], shares[i], shares[j]})
+				require.NoError(t, err)
+				require.Equal(t, secret, recovered)
+			}
+		}
+	}
+
+	recovered, err := Combine(shares)
+	require.NoError(t, err)
+	require.Equal(t, secret, recovered)
+
+	// 2 shares reveal nothing
+	recovered, err = Combine(shares[:2])
+	require.NoError(t, err)
+	require.NotEqual(t, secret, recovered)
+
+	_, err = Combine([][]byte{shares[0], shares[0]})
+	require.Error(t, err)
+
+	_, err = Split(secret, 2, 3)
+	require.Error(t, err)
+	_, err = Split(secret, 3, 1)
+	require.Error(t, err)
+	_, err = Split(nil, 3, 2)
+	require.Error(t, err)
+}
+
+func TestField(t *testing.T) {
+	for a := 1; a < 256; a++ {
+		for b := 1; b < 256; b++ {
+			require.Equal(t, byte(a), div(mul(byte(a), byte(b)), byte(b)))
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/go-bip39"
+
+	"github.com/irisnet/irishub-sdk-go/crypto/shamir"
+)
+
+// The shares of a secret are the text
+//
+//	sss1:<kind>:<threshold>:<hex share>
+//
+// kind is `mnemonic` for the entropy of a BIP39 mnemonic or `privkey` for an armored private key
+// exported by ExportPrivKey. The shared secret is the data followed by the first 4 bytes of its
+// sha256, so that combining the shares of different secrets or too few shares is detected.
+const (
+	ShareKindMnemonic = "mnemonic"
+	ShareKindPrivKey  = "privkey"
+
+	sharePrefix   = "sss1"
+	checksumBytes = 4
+)
+
+// SplitMnemonic splits the entropy of the mnemonic into n shares, any threshold of them can recover the mnemonic
+func SplitMnemonic(mnemonic string, n, threshold int) ([]string, error) {
+	entropy, err := entropyFromMnemonic(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	return splitSecret(ShareKindMnemonic, entropy, n, threshold)
+}
+
+// CombineMnemonic recovers the mnemonic from its shares
+func CombineMnemonic(shares []string) (string, error) {
+	entropy, err := combineSecret(ShareKindMnemonic, shares)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// SplitPrivKey splits the armored private key into n shares, any threshold of them can recover the armor
+func SplitPrivKey(armor string, n, threshold int) ([]string, error) {
+	return splitSecret(ShareKindPrivKey, []byte(armor), n, threshold)
+}
+
+// CombinePrivKey recovers the armored private key from its shares
+func CombinePrivKey(shares []string) (string, error) {
+	armor, err := combineSecret(ShareKindPrivKey, shares)
+	if err != nil {
+		return "", err
+	}
+	return string(armor), nil
+}
+
+// ShareKind returns the kind of the secret of the share
+func ShareKind(share string) (string, error) {
+	kind, _, _, err := parseShare(share)
+	return kind, err
+}
+
+// entropyFromMnemonic returns the entropy of the mnemonic without the checksum bits
+func entropyFromMnemonic(mnemonic string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	if !bip39.IsMnemonicValid(strings.Join(words, " ")) {
+		return nil, fmt.Errorf("invalid mnemonic")
+	}
+
+	bitSize := len(words) * 11
+	checksumSize := bitSize / 33
+
+	b := new(big.Int)
+	for _, word := range words {
+		b.Lsh(b, 11)
+		b.Or(b, big.NewInt(int64(bip39.ReverseWordMap[word])))
+	}
+	b.Rsh(b, uint(checksumSize))
+
+	return b.FillBytes(make([]byte, (bitSize-checksumSize)/8)), nil
+}
+
+func splitSecret(kind string, data []byte, n, threshold int) ([]string, error) {
+	checksum := sha256.Sum256(data)
+	secret := append(append([]byte{}, data...), checksum[:checksumBytes]...)
+
+	parts, err := shamir.Split(secret, n, threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	shares := make([]string, len(parts))
+	for i, part := range parts {
+		shares[i] = strings.Join([]string{sharePrefix, kind, strconv.Itoa(threshold), hex.EncodeToString(part)}, ":")
+	}
+	return shares, nil
+}
+
+func combineSecret(kind string, shares []string) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, fmt.Errorf("no shares")
+	}
+
+	parts := make([][]byte, len(shares))
+	var threshold int
+	for i, share := range shares {
+		shareKind, shareThreshold, part, err := parseShare(share)
+		if err != nil {
+			return nil, err
+		}
+		if shareKind != kind {
+			return nil, fmt.Errorf("share %d is the share of a %s instead of a %s", i, shareKind, kind)
+		}
+		if i > 0 && shareThreshold != threshold {
+			return nil, fmt.Errorf("the shares have different thresholds")
+		}
+		threshold = shareThreshold
+		parts[i] = part
+	}
+
+	if len(shares) < threshold {
+		return nil, fmt.Errorf("%d shares are required, got %d", threshold, len(shares))
+	}
+
+	secret, err := shamir.Combine(parts)
+	if err != nil {
+		return nil, err
+	}
+	if len(secret) <= checksumBytes {
+		return nil, fmt.Errorf("invalid shares")
+	}
+
+	data, checksum := secret[:len(secret)-checksumBytes], secret[len(secret)-checksumBytes:]
+	expected := sha256.Sum256(data)
+	if !bytes.Equal(checksum, expected[:checksumBytes]) {
+		return nil, fmt.Errorf("invalid shares, the checksum does not match")
+	}
+	return data, nil
+}
+
+func parseShare(share string) (kind string, threshold int, part []byte, err error) {
+	fields := strings.Split(strings.TrimSpace(share), ":")
+	if len(fields) != 4 || fields[0] != sharePrefix {
+		return "", 0, nil, fmt.Errorf("invalid share format")
+	}
+
+	kind = fields[1]
+	if kind != ShareKindMnemonic && kind != ShareKindPrivKey {
+		return "", 0, nil, fmt.Errorf("unknown share kind: %s", kind)
+	}
+
+	if threshold, err = strconv.Atoi(fields[2]); err != nil || threshold < 2 {
+		return "", 0, nil, fmt.Errorf("invalid share threshold: %s", fields[2])
+	}
+
+	if part, err = hex.DecodeString(fields[3]); err != nil {
+		return "", 0, nil, err
+	}
+	return kind, threshold, part, nil
+}
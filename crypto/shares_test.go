@@ -0,0 +1,65 @@
+package crypto_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/irisnet/irishub-sdk-go/crypto"
+)
+
+func TestSplitMnemonic(t *testing.T) {
+	mnemonic := "nerve leader thank marriage spice task van start piece crowd run hospital control outside cousin romance left choice poet wagon rude climb leisure spring"
+
+	shares, err := crypto.SplitMnemonic(mnemonic, 5, 3)
+	require.NoError(t, err)
+	require.Len(t, shares, 5)
+
+	kind, err := crypto.ShareKind(shares[0])
+	require.NoError(t, err)
+	require.Equal(t, crypto.ShareKindMnemonic, kind)
+
+	recovered, err := crypto.CombineMnemonic([]string{shares[4], shares[1], shares[2]})
+	require.NoError(t, err)
+	require.Equal(t, mnemonic, recovered)
+
+	_, err = crypto.CombineMnemonic(shares[:2])
+	require.Error(t, err)
+
+	// the shares of another mnemonic
+	km, err := crypto.NewAlgoKeyManager("secp256k1")
+	require.NoError(t, err)
+	another, _ := km.Generate()
+	anotherShares, err := crypto.SplitMnemonic(another, 5, 3)
+	require.NoError(t, err)
+	_, err = crypto.CombineMnemonic([]string{shares[0], shares[1], anotherShares[2]})
+	require.Error(t, err)
+
+	// 12 words mnemonic
+	short := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	shares, err = crypto.SplitMnemonic(short, 2, 2)
+	require.NoError(t, err)
+	recovered, err = crypto.CombineMnemonic(shares)
+	require.NoError(t, err)
+	require.Equal(t, short, recovered)
+
+	_, err = crypto.SplitMnemonic("invalid mnemonic", 2, 2)
+	require.Error(t, err)
+}
+
+func TestSplitPrivKey(t *testing.T) {
+	km, err := crypto.NewAlgoKeyManager("sm2")
+	require.NoError(t, err)
+	armor, err := km.ExportPrivKey("12345678")
+	require.NoError(t, err)
+
+	shares, err := crypto.SplitPrivKey(armor, 3, 2)
+	require.NoError(t, err)
+
+	_, err = crypto.CombineMnemonic(shares)
+	require.Error(t, err)
+
+	recovered, err := crypto.CombinePrivKey(shares[1:])
+	require.NoError(t, err)
+	require.Equal(t, armor, recovered)
+}
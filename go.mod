@@ -7,9 +7,12 @@ require (
 	github.com/btcsuite/btcd v0.21.0-beta
 	github.com/btcsuite/btcutil v1.0.2
 	github.com/cosmos/go-bip39 v0.0.0-20180819234021-555e2067c45d
+	github.com/dvsekhvalnov/jose2go v0.0.0-20200901110807-248326c1351b
+	github.com/gofrs/flock v0.8.1
 	github.com/gogo/protobuf v1.3.1
 	github.com/golang/protobuf v1.4.3
 	github.com/magiconair/properties v1.8.1
+	github.com/mtibben/percent v0.2.1
 	github.com/pkg/errors v0.9.1
 	github.com/regen-network/cosmos-proto v0.3.0
 	github.com/sirupsen/logrus v1.6.0
@@ -19,6 +22,7 @@ require (
 	github.com/tendermint/go-amino v0.15.1
 	github.com/tendermint/tendermint v0.34.1
 	github.com/tendermint/tm-db v0.6.3
+	github.com/tjfoc/gmsm v1.3.2
 	golang.org/x/crypto v0.0.0-20201016220609-9e8e0b390897
 	google.golang.org/genproto v0.0.0-20201214200347-8c77b98c765d
 	google.golang.org/grpc v1.34.0
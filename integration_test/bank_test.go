@@ -6,7 +6,6 @@ import (
 	"github.com/irisnet/irishub-sdk-go/modules/bank"
 	"github.com/irisnet/irishub-sdk-go/types"
 	"github.com/stretchr/testify/require"
-	"math/rand"
 	"sync"
 	"time"
 )
@@ -56,7 +55,7 @@ func send(s IntegrationTestSuite) {
 	to := s.GetRandAccount().Address.String()
 
 	ch := make(chan int)
-	s.Bank.SubscribeSendTx(s.Account().Address.String(), to, func(send bank.EventDataMsgSend) {
+	subscription := s.Bank.SubscribeSendTx(s.Account().Address.String(), to, func(send bank.EventDataMsgSend) {
 		ch <- 1
 	})
 
@@ -72,14 +71,19 @@ func send(s IntegrationTestSuite) {
 	res, err := s.Bank.Send(to, coins, baseTx)
 	s.NoError(err)
 	s.NotEmpty(res.Hash)
-	time.Sleep(1 * time.Second)
+	s.sleep(1 * time.Second)
 
 	resp, err := s.Manager().QueryTx(res.Hash)
 	s.NoError(err)
 	s.Equal(resp.Result.Code, uint32(0))
 	s.Equal(resp.Height, res.Height)
 
-	<-ch
+	select {
+	case <-ch:
+	case <-time.After(time.Minute):
+		s.Fail("the send event is not received")
+	}
+	s.NoError(s.Unsubscribe(subscription))
 }
 
 func multiSend(s IntegrationTestSuite) {
@@ -99,7 +103,7 @@ func multiSend(s IntegrationTestSuite) {
 	receipts := make([]bank.Receipt, accNum)
 	for i := 0; i < accNum; i++ {
 		acc[i] = s.RandStringOfLength(10)
-		addr, _, err := s.Key.Add(acc[i], "1234567890")
+		addr, err := s.addKey(acc[i], "1234567890")
 
 		s.NoError(err)
 		s.NotEmpty(addr)
@@ -118,9 +122,11 @@ func multiSend(s IntegrationTestSuite) {
 	to := s.GetRandAccount().Address.String()
 	begin := time.Now()
 	var wait sync.WaitGroup
+	// the senders are distinct, concurrent transactions of an account would race for its sequence
+	senders := s.r.Perm(accNum)
 	for i := 1; i < 5; i++ {
 		wait.Add(1)
-		index := rand.Intn(accNum)
+		index := senders[i]
 		go func() {
 			defer wait.Done()
 			_, err := s.Bank.Send(to, coins, types.BaseTx{
@@ -0,0 +1,103 @@
+package integration_test
+
+import (
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+func (s IntegrationTestSuite) TestDistribution() {
+	cases := []SubTest{
+		{
+			"TestWithdrawRewards",
+			withdrawRewards,
+		},
+		{
+			"TestDistributionQueries",
+			queryDistribution,
+		},
+	}
+
+	for _, t := range cases {
+		s.Run(t.testName, func() {
+			t.testCase(s)
+		})
+	}
+}
+
+func withdrawRewards(s IntegrationTestSuite) {
+	delegatorAddr := s.Account().Address.String()
+	baseTx := sdk.BaseTx{
+		From:     s.Account().Name,
+		Gas:      400000,
+		Memo:     "test",
+		Mode:     sdk.Commit,
+		Password: s.Account().Password,
+	}
+
+	validators, err := s.Distribution.QueryDelegatorValidators(delegatorAddr)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), validators)
+
+	rewards, err := s.Distribution.QueryDelegationTotalRewards(delegatorAddr)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), rewards.Rewards)
+
+	res, err := s.Distribution.WithdrawRewards(validators[0], baseTx)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), res.Hash)
+
+	res, err = s.Distribution.WithdrawAllRewards(baseTx)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), res.Hash)
+
+	// the root account is the operator of the validator
+	res, err = s.Distribution.WithdrawValidatorCommission(baseTx)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), res.Hash)
+
+	withdrawAddr := s.GetRandAccount().Address.String()
+	res, err = s.Distribution.SetWithdrawAddr(withdrawAddr, baseTx)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), res.Hash)
+
+	addr, err := s.Distribution.QueryWithdrawAddr(delegatorAddr)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), withdrawAddr, addr)
+
+	res, err = s.Distribution.SetWithdrawAddr(delegatorAddr, baseTx)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), res.Hash)
+
+	pool, err := s.Distribution.QueryCommunityPool()
+	require.NoError(s.T(), err)
+
+	amount, _ := sdk.ParseDecCoins("1iris")
+	res, err = s.Distribution.FundCommunityPool(amount, baseTx)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), res.Hash)
+
+	funded, err := s.Distribution.QueryCommunityPool()
+	require.NoError(s.T(), err)
+	require.True(s.T(), funded.AmountOf("uiris").GT(pool.AmountOf("uiris")))
+}
+
+func queryDistribution(s IntegrationTestSuite) {
+	params, err := s.Distribution.QueryParams()
+	require.NoError(s.T(), err)
+	require.True(s.T(), params.CommunityTax.IsPositive())
+
+	validators, err := s.Staking.QueryValidators("", 1, 10)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), validators.Validators)
+	validatorAddr := validators.Validators[0].OperatorAddress
+
+	_, err = s.Distribution.QueryValidatorOutstandingRewards(validatorAddr)
+	require.NoError(s.T(), err)
+
+	_, err = s.Distribution.QueryValidatorCommission(validatorAddr)
+	require.NoError(s.T(), err)
+
+	_, err = s.Distribution.QueryDelegationRewards(s.Account().Address.String(), validatorAddr)
+	require.NoError(s.T(), err)
+}
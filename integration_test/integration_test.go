@@ -1,6 +1,7 @@
 package integration_test
 
 import (
+	"hash/fnv"
 	"io/ioutil"
 	"math/rand"
 	"os"
@@ -9,10 +10,12 @@ import (
 	"time"
 
 	sdk "github.com/irisnet/irishub-sdk-go"
+	"github.com/irisnet/irishub-sdk-go/testutil/recorder"
 	"github.com/irisnet/irishub-sdk-go/types"
 	"github.com/irisnet/irishub-sdk-go/types/store"
 	"github.com/irisnet/irishub-sdk-go/utils/log"
 
+	bip39 "github.com/cosmos/go-bip39"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -22,12 +25,20 @@ const (
 	chainID  = "testing"
 	charset  = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 	addr     = "iaa1qzds87rxyrv4ak9gr2mx9ptjhsqs5thcha0e5n"
+
+	// fixtureModeEnv selects how the node is reached: `record`, `replay` or `passthrough` (default)
+	fixtureModeEnv = "FIXTURE_MODE"
+	// fixtureDirEnv overrides the directory of the fixtures, each test is recorded into its own
+	// `<test>.json` file so that it can be replayed alone
+	fixtureDirEnv     = "FIXTURE_DIR"
+	defaultFixtureDir = "testdata"
 )
 
 type IntegrationTestSuite struct {
 	suite.Suite
 	sdk.IRISHUBClient
 	r            *rand.Rand
+	recorder     *recorder.Recorder
 	rootAccount  MockAccount
 	randAccounts []MockAccount
 }
@@ -52,13 +63,21 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		types.KeyDAOOption(store.NewMemory(nil)),
 		types.TimeoutOption(10),
 	}
+
+	seed := time.Now().UnixNano()
+	if mode := recorder.Mode(os.Getenv(fixtureModeEnv)); mode != "" && mode != recorder.Passthrough {
+		options = append(options, s.recorderOptions(mode)...)
+		// the random accounts must be the same while recording and replaying
+		seed = 1
+	}
+
 	cfg, err := types.NewClientConfig(nodeURI, grpcAddr, chainID, options...)
 	if err != nil {
 		panic(err)
 	}
 
 	s.IRISHUBClient = sdk.NewIRISHUBClient(cfg)
-	s.r = rand.New(rand.NewSource(time.Now().UnixNano()))
+	s.r = rand.New(rand.NewSource(seed))
 	s.rootAccount = MockAccount{
 		Name:     "validator",
 		Password: "12345678",
@@ -71,6 +90,62 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	s.initAccount()
 }
 
+// BeforeTest switches the recorder to the fixture file of the test, whose random values
+// are seeded by its name so that it can be recorded and replayed alone
+func (s *IntegrationTestSuite) BeforeTest(_, testName string) {
+	if s.recorder == nil {
+		return
+	}
+
+	dir := os.Getenv(fixtureDirEnv)
+	if len(dir) == 0 {
+		dir = defaultFixtureDir
+	}
+	if err := s.recorder.Open(filepath.Join(dir, testName+".json")); err != nil {
+		panic(err)
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(testName))
+	s.r = rand.New(rand.NewSource(int64(h.Sum64())))
+}
+
+func (s *IntegrationTestSuite) AfterTest(_, _ string) {
+	if s.recorder == nil {
+		return
+	}
+	if err := s.recorder.Save(); err != nil {
+		panic(err)
+	}
+}
+
+// recorderOptions serves the rpc and grpc calls of the suite with the fixtures
+func (s *IntegrationTestSuite) recorderOptions(mode recorder.Mode) []types.Option {
+	rec, err := recorder.New("", mode)
+	if err != nil {
+		panic(err)
+	}
+	s.recorder = rec
+
+	var tmClient types.TmClient
+	var grpcClient types.GRPCClient
+	if mode == recorder.Record {
+		cfg, err := types.NewClientConfig(nodeURI, grpcAddr, chainID)
+		if err != nil {
+			panic(err)
+		}
+		node := sdk.NewIRISHUBClient(cfg)
+		tmClient, grpcClient = node.BaseClient, node.BaseClient
+	}
+
+	return []types.Option{
+		types.TmClientOption(recorder.NewTmClient(tmClient, rec)),
+		types.GRPCClientOption(recorder.NewGRPCClient(grpcClient, rec)),
+		// the cached accounts expire with time, every call must be made while recording and replaying
+		types.CachedOption(false),
+	}
+}
+
 func (s *IntegrationTestSuite) initAccount() {
 	_, err := s.Key.Import(
 		s.Account().Name,
@@ -85,7 +160,7 @@ func (s *IntegrationTestSuite) initAccount() {
 	for i := 0; i < 5; i++ {
 		name := s.RandStringOfLength(10)
 		pwd := s.RandStringOfLength(16)
-		address, _, err := s.Key.Add(name, "11111111")
+		address, err := s.addKey(name, pwd)
 		if err != nil {
 			panic("generate test account failed")
 		}
@@ -98,6 +173,26 @@ func (s *IntegrationTestSuite) initAccount() {
 	}
 }
 
+// addKey adds a key whose mnemonic is drawn from the random source of the suite,
+// so that its address is the same while recording and replaying
+func (s *IntegrationTestSuite) addKey(name, password string) (string, types.Error) {
+	entropy := make([]byte, 32)
+	_, _ = s.r.Read(entropy)
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	if err != nil {
+		return "", types.Wrap(err)
+	}
+	return s.Key.Recover(name, password, mnemonic)
+}
+
+// sleep waits for the node to make progress, the fixtures already hold its result when replaying
+func (s *IntegrationTestSuite) sleep(d time.Duration) {
+	if s.recorder != nil && s.recorder.Mode() == recorder.Replay {
+		return
+	}
+	time.Sleep(d)
+}
+
 // RandStringOfLength return a random string
 func (s *IntegrationTestSuite) RandStringOfLength(l int) string {
 	var result []byte
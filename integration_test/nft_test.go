@@ -70,7 +70,7 @@ func (s IntegrationTestSuite) TestNFT() {
 	uName := s.RandStringOfLength(10)
 	pwd := "11111111"
 
-	recipient, _, err := s.Key.Add(uName, pwd)
+	recipient, err := s.addKey(uName, pwd)
 	require.NoError(s.T(), err)
 
 	transferReq := nft.TransferNFTRequest{
@@ -9,9 +9,7 @@ import (
 	"time"
 )
 
-var serviceName = generateServiceName()
-
-func (s *IntegrationTestSuite) SetupService(ch chan<- int) {
+func (s *IntegrationTestSuite) SetupService(serviceName string, ch chan<- int) sdk.Subscription {
 	schemas := `{"input":{"type":"object"},"output":{"type":"object"},"error":{"type":"object"}}`
 	pricing := `{"price":"1uiris"}`
 	output := `{"header":{},"body":{"last":"100"}}`
@@ -48,7 +46,7 @@ func (s *IntegrationTestSuite) SetupService(ch chan<- int) {
 	_, err = s.Service.BindService(binding, baseTx)
 	require.NoError(s.T(), err)
 
-	_, err = s.Service.SubscribeServiceRequest(
+	subscription, err := s.Service.SubscribeServiceRequest(
 		definition.ServiceName,
 		func(reqCtxID, reqID, input string) (string, string) {
 			s.Logger().Info("Service received request", "input", input, "reqCtxID", reqCtxID, "reqID", reqID, "output", output)
@@ -57,11 +55,13 @@ func (s *IntegrationTestSuite) SetupService(ch chan<- int) {
 		}, baseTx)
 
 	require.NoError(s.T(), err)
+	return subscription
 }
 
 func (s IntegrationTestSuite) TestOracle() {
 	var ch = make(chan int)
-	s.SetupService(ch)
+	serviceName := s.generateServiceName()
+	subscription := s.SetupService(serviceName, ch)
 
 	baseTx := sdk.BaseTx{
 		From:     s.Account().Name,
@@ -101,6 +101,8 @@ func (s IntegrationTestSuite) TestOracle() {
 	select {
 	case <-ch:
 
+		// the provider responds once the callback returns, the sleep is kept while replaying
+		// so that its transaction is not sent concurrently with the next ones
 		time.Sleep(2 * time.Second)
 
 		feedValuesRep, err := s.Oracle.QueryFeedValue(feedName)
@@ -135,10 +137,11 @@ func (s IntegrationTestSuite) TestOracle() {
 		require.NotEmpty(s.T(), feedsRep)
 		require.Equal(s.T(), int32(service.PAUSED), feedRep.State)
 	}
+	require.NoError(s.T(), s.Unsubscribe(subscription))
 }
 
-func generateServiceName() string {
-	return fmt.Sprintf("service-%d", time.Now().Nanosecond())
+func (s IntegrationTestSuite) generateServiceName() string {
+	return fmt.Sprintf("service-%s", s.RandStringOfLength(10))
 }
 
 func generateFeedName(serviceName string) string {
@@ -67,7 +67,7 @@ func requestRandom(s IntegrationTestSuite) {
 
 func queryRandom(s IntegrationTestSuite) {
 	// Wait for the transaction to be packaged into the block
-	time.Sleep(10 * time.Second)
+	s.sleep(10 * time.Second)
 	res, err := s.Random.QueryRandom(testRandom.reqId)
 	s.NoError(err)
 	s.NotEmpty(res.RequestTxHash)
@@ -140,7 +140,7 @@ loop:
 	require.Equal(s.T(), request.ServiceName, invocation.ServiceName)
 	require.Equal(s.T(), request.Input, invocation.Input)
 
-	addr, _, err := s.Key.Add(s.RandStringOfLength(30), "1234567890")
+	addr, err := s.addKey(s.RandStringOfLength(30), "1234567890")
 	require.NoError(s.T(), err)
 	require.NotEmpty(s.T(), addr)
 
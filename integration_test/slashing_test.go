@@ -0,0 +1,38 @@
+package integration_test
+
+import (
+	"github.com/stretchr/testify/require"
+)
+
+func (s IntegrationTestSuite) TestSlashing() {
+	cases := []SubTest{
+		{
+			"TestQuerySlashing",
+			querySlashing,
+		},
+	}
+
+	for _, t := range cases {
+		s.Run(t.testName, func() {
+			t.testCase(s)
+		})
+	}
+}
+
+func querySlashing(s IntegrationTestSuite) {
+	params, err := s.Slashing.QueryParams()
+	require.NoError(s.T(), err)
+	require.Greater(s.T(), params.SignedBlocksWindow, int64(0))
+	require.True(s.T(), params.MinSignedPerWindow.IsPositive())
+
+	infos, err := s.Slashing.QuerySigningInfos(1, 10)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), infos.SigningInfos)
+	require.Greater(s.T(), infos.Total, uint64(0))
+
+	consAddr := infos.SigningInfos[0].Address
+	info, err := s.Slashing.QuerySigningInfo(consAddr)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), consAddr, info.Address)
+	require.False(s.T(), info.Tombstoned)
+}
@@ -11,9 +11,13 @@ import (
 	"github.com/irisnet/irishub-sdk-go/modules/auth"
 	"github.com/irisnet/irishub-sdk-go/modules/bank"
 	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/query"
 	"github.com/irisnet/irishub-sdk-go/utils/cache"
 )
 
+// balancesPageSize is the number of balances queried in a request
+const balancesPageSize = 100
+
 // Must be used with locker, otherwise there are thread safety issues
 type accountQuery struct {
 	sdk.Queries
@@ -59,23 +63,39 @@ func (a accountQuery) QueryAccount(address string) (sdk.BaseAccount, sdk.Error)
 		return sdk.BaseAccount{}, sdk.Wrap(err)
 	}
 
-	var baseAccount auth.Account
-	if err := a.cdc.UnpackAny(response.Account, &baseAccount); err != nil {
+	var acc auth.Account
+	if err := a.cdc.UnpackAny(response.Account, &acc); err != nil {
 		return sdk.BaseAccount{}, sdk.Wrap(err)
 	}
 
-	account := baseAccount.(*auth.BaseAccount).ConvertAccount(a.cdc).(sdk.BaseAccount)
-
-	breq := &bank.QueryAllBalancesRequest{
-		Address:    address,
-		Pagination: nil,
-	}
-	balances, err := bank.NewQueryClient(conn).AllBalances(context.Background(), breq)
+	// module and vesting accounts are signed with the number and sequence of their base account
+	baseAccount, err := auth.GetBaseAccount(acc)
 	if err != nil {
 		return sdk.BaseAccount{}, sdk.Wrap(err)
 	}
+	account := baseAccount.ConvertAccount(a.cdc).(sdk.BaseAccount)
+
+	// the balances are queried page by page, an account may hold too many tokens for a response
+	var nextKey []byte
+	for {
+		breq := &bank.QueryAllBalancesRequest{
+			Address: address,
+			Pagination: &query.PageRequest{
+				Key:   nextKey,
+				Limit: balancesPageSize,
+			},
+		}
+		balances, err := bank.NewQueryClient(conn).AllBalances(context.Background(), breq)
+		if err != nil {
+			return sdk.BaseAccount{}, sdk.Wrap(err)
+		}
 
-	account.Coins = balances.Balances
+		account.Coins = append(account.Coins, balances.Balances...)
+		if balances.Pagination == nil || len(balances.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = balances.Pagination.NextKey
+	}
 	return account, nil
 }
 
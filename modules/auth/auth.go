@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+type authClient struct {
+	sdk.BaseClient
+	codec.Marshaler
+}
+
+func NewClient(baseClient sdk.BaseClient, marshaler codec.Marshaler) Client {
+	return &authClient{
+		BaseClient: baseClient,
+		Marshaler:  marshaler,
+	}
+}
+
+func (ac authClient) Name() string {
+	return ModuleName
+}
+
+func (ac authClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
+	RegisterInterfaces(registry)
+}
+
+func (ac authClient) QueryAccount(address string) (QueryAccountResp, sdk.Error) {
+	conn, err := ac.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return QueryAccountResp{}, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).Account(
+		context.Background(),
+		&QueryAccountRequest{
+			Address: address,
+		},
+	)
+	if err != nil {
+		return QueryAccountResp{}, sdk.Wrap(err)
+	}
+
+	var account Account
+	if err := ac.UnpackAny(res.Account, &account); err != nil {
+		return QueryAccountResp{}, sdk.Wrap(err)
+	}
+
+	status, err := ac.Status(context.Background())
+	if err != nil {
+		return QueryAccountResp{}, sdk.Wrap(err)
+	}
+
+	resp, err := newQueryAccountResp(ac.Marshaler, account, status.SyncInfo.LatestBlockTime)
+	if err != nil {
+		return QueryAccountResp{}, sdk.Wrap(err)
+	}
+
+	// the balances are queried by the base client page by page
+	baseAccount, err := ac.BaseClient.QueryAccount(address)
+	if err != nil {
+		return QueryAccountResp{}, sdk.Wrap(err)
+	}
+	resp.Balances = baseAccount.Coins
+	resp.Spendable = SpendableCoins(resp.Balances, resp.Locked)
+	return resp, nil
+}
+
+func (ac authClient) QueryParams() (QueryParamsResp, sdk.Error) {
+	res, err := ac.QueryParamsResponse(context.Background())
+	if err != nil {
+		return QueryParamsResp{}, sdk.Wrap(err)
+	}
+	return res.Convert().(QueryParamsResp), nil
+}
+
+// QueryParamsResponse returns the grpc response of the params at the height of the context
+func (ac authClient) QueryParamsResponse(ctx context.Context) (sdk.Response, error) {
+	conn, err := ac.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewQueryClient(conn).Params(ctx, &QueryParamsRequest{})
+}
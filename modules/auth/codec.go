@@ -0,0 +1,16 @@
+package auth
+
+import (
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+)
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*Account)(nil),
+		&BaseAccount{},
+		&ModuleAccount{},
+		&ContinuousVestingAccount{},
+		&DelayedVestingAccount{},
+		&PeriodicVestingAccount{},
+	)
+}
@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"time"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// expose auth module api for user
+type Client interface {
+	sdk.Module
+
+	// QueryAccount returns the account of any type with its balances, the locked and spendable
+	// amounts are computed at the time of the latest block
+	QueryAccount(address string) (QueryAccountResp, sdk.Error)
+	QueryParams() (QueryParamsResp, sdk.Error)
+}
+
+// the types of the accounts
+const (
+	BaseAccountType              = "base"
+	ModuleAccountType            = "module"
+	ContinuousVestingAccountType = "continuous_vesting"
+	DelayedVestingAccountType    = "delayed_vesting"
+	PeriodicVestingAccountType   = "periodic_vesting"
+)
+
+type QueryAccountResp struct {
+	Type          string `json:"type"`
+	Address       string `json:"address"`
+	PubKey        string `json:"pub_key"`
+	AccountNumber uint64 `json:"account_number"`
+	Sequence      uint64 `json:"sequence"`
+	// Module is set if the type is ModuleAccountType
+	Module *ModuleResp `json:"module,omitempty"`
+	// Vesting is set if the type is one of the vesting types
+	Vesting   *VestingResp `json:"vesting,omitempty"`
+	Balances  sdk.Coins    `json:"balances"`
+	Locked    sdk.Coins    `json:"locked"`
+	Spendable sdk.Coins    `json:"spendable"`
+	BlockTime time.Time    `json:"block_time"`
+}
+
+type ModuleResp struct {
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+type VestingResp struct {
+	OriginalVesting  sdk.Coins `json:"original_vesting"`
+	DelegatedFree    sdk.Coins `json:"delegated_free"`
+	DelegatedVesting sdk.Coins `json:"delegated_vesting"`
+	// StartTime is zero for a delayed vesting account
+	StartTime time.Time    `json:"start_time"`
+	EndTime   time.Time    `json:"end_time"`
+	Periods   []PeriodResp `json:"periods,omitempty"`
+	Vested    sdk.Coins    `json:"vested"`
+	Vesting   sdk.Coins    `json:"vesting"`
+}
+
+type PeriodResp struct {
+	Length time.Duration `json:"length"`
+	Amount sdk.Coins     `json:"amount"`
+}
+
+type QueryParamsResp struct {
+	MaxMemoCharacters      uint64 `json:"max_memo_characters"`
+	TxSigLimit             uint64 `json:"tx_sig_limit"`
+	TxSizeCostPerByte      uint64 `json:"tx_size_cost_per_byte"`
+	SigVerifyCostED25519   uint64 `json:"sig_verify_cost_ed25519"`
+	SigVerifyCostSecp256k1 uint64 `json:"sig_verify_cost_secp256k1"`
+}
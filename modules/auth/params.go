@@ -9,3 +9,33 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+// String implements the stringer interface.
+func (p Period) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// String implements the stringer interface.
+func (bva BaseVestingAccount) String() string {
+	out, _ := yaml.Marshal(bva)
+	return string(out)
+}
+
+// String implements the stringer interface.
+func (cva ContinuousVestingAccount) String() string {
+	out, _ := yaml.Marshal(cva)
+	return string(out)
+}
+
+// String implements the stringer interface.
+func (dva DelayedVestingAccount) String() string {
+	out, _ := yaml.Marshal(dva)
+	return string(out)
+}
+
+// String implements the stringer interface.
+func (pva PeriodicVestingAccount) String() string {
+	out, _ := yaml.Marshal(pva)
+	return string(out)
+}
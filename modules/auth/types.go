@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/tendermint/tendermint/crypto"
@@ -14,6 +15,10 @@ import (
 	sdk "github.com/irisnet/irishub-sdk-go/types"
 )
 
+const (
+	ModuleName = "auth"
+)
+
 // Account is an interface used to store coins at a given address within state.
 // It presumes a notion of sequence numbers for replay protection,
 // a notion of account numbers for replay protection for previously pruned accounts,
@@ -145,3 +150,106 @@ func (acc *BaseAccount) ConvertAccount(cdc codec.Marshaler) interface{} {
 	account.PubKey = pkStr
 	return account
 }
+
+// GetBaseAccount returns the BaseAccount embedded in the account of any type
+func GetBaseAccount(acc Account) (*BaseAccount, error) {
+	var baseAccount *BaseAccount
+	switch acc := acc.(type) {
+	case *BaseAccount:
+		baseAccount = acc
+	case *ModuleAccount:
+		baseAccount = acc.BaseAccount
+	case *ContinuousVestingAccount:
+		if acc.BaseVestingAccount != nil {
+			baseAccount = acc.BaseAccount
+		}
+	case *DelayedVestingAccount:
+		if acc.BaseVestingAccount != nil {
+			baseAccount = acc.BaseAccount
+		}
+	case *PeriodicVestingAccount:
+		if acc.BaseVestingAccount != nil {
+			baseAccount = acc.BaseAccount
+		}
+	default:
+		return nil, fmt.Errorf("unknown account type %T", acc)
+	}
+
+	if baseAccount == nil {
+		return nil, fmt.Errorf("%T has no base account", acc)
+	}
+	return baseAccount, nil
+}
+
+// newQueryAccountResp returns the typed account without the balances, the vesting coins are
+// computed at the block time
+func newQueryAccountResp(cdc codec.Marshaler, acc Account, blockTime time.Time) (QueryAccountResp, error) {
+	baseAccount, err := GetBaseAccount(acc)
+	if err != nil {
+		return QueryAccountResp{}, err
+	}
+	account := baseAccount.ConvertAccount(cdc).(sdk.BaseAccount)
+
+	resp := QueryAccountResp{
+		Type:          BaseAccountType,
+		Address:       account.Address,
+		PubKey:        account.PubKey,
+		AccountNumber: account.AccountNumber,
+		Sequence:      account.Sequence,
+		Locked:        sdk.NewCoins(),
+		BlockTime:     blockTime,
+	}
+
+	var periods []PeriodResp
+	switch acc := acc.(type) {
+	case *ModuleAccount:
+		resp.Type = ModuleAccountType
+		resp.Module = &ModuleResp{
+			Name:        acc.Name,
+			Permissions: acc.Permissions,
+		}
+	case *ContinuousVestingAccount:
+		resp.Type = ContinuousVestingAccountType
+	case *DelayedVestingAccount:
+		resp.Type = DelayedVestingAccountType
+	case *PeriodicVestingAccount:
+		resp.Type = PeriodicVestingAccountType
+		for _, period := range acc.VestingPeriods {
+			periods = append(periods, PeriodResp{
+				Length: time.Duration(period.Length) * time.Second,
+				Amount: period.Amount,
+			})
+		}
+	}
+
+	if vacc, ok := acc.(VestingAccount); ok {
+		resp.Vesting = &VestingResp{
+			OriginalVesting:  vacc.GetOriginalVesting(),
+			DelegatedFree:    vacc.GetDelegatedFree(),
+			DelegatedVesting: vacc.GetDelegatedVesting(),
+			EndTime:          time.Unix(vacc.GetEndTime(), 0).UTC(),
+			Periods:          periods,
+			Vested:           vacc.GetVestedCoins(blockTime),
+			Vesting:          vacc.GetVestingCoins(blockTime),
+		}
+		if startTime := vacc.GetStartTime(); startTime > 0 {
+			resp.Vesting.StartTime = time.Unix(startTime, 0).UTC()
+		}
+		resp.Locked = vacc.LockedCoins(blockTime)
+	}
+	return resp, nil
+}
+
+func (p Params) Convert() interface{} {
+	return QueryParamsResp{
+		MaxMemoCharacters:      p.MaxMemoCharacters,
+		TxSigLimit:             p.TxSigLimit,
+		TxSizeCostPerByte:      p.TxSizeCostPerByte,
+		SigVerifyCostED25519:   p.SigVerifyCostED25519,
+		SigVerifyCostSecp256k1: p.SigVerifyCostSecp256k1,
+	}
+}
+
+func (q QueryParamsResponse) Convert() interface{} {
+	return q.Params.Convert()
+}
@@ -0,0 +1,172 @@
+package auth
+
+import (
+	"time"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// VestingAccount defines an account which vests its original vesting coins by a schedule,
+// the times are the unix timestamps of the schedule.
+type VestingAccount interface {
+	Account
+
+	// GetVestedCoins returns the original vesting coins vested at the block time
+	GetVestedCoins(blockTime time.Time) sdk.Coins
+	// GetVestingCoins returns the original vesting coins still vesting at the block time
+	GetVestingCoins(blockTime time.Time) sdk.Coins
+	// LockedCoins returns the vesting coins which are not delegated, they can not be spent
+	LockedCoins(blockTime time.Time) sdk.Coins
+
+	GetStartTime() int64
+	GetEndTime() int64
+	GetOriginalVesting() sdk.Coins
+	GetDelegatedFree() sdk.Coins
+	GetDelegatedVesting() sdk.Coins
+}
+
+var (
+	_ VestingAccount = (*ContinuousVestingAccount)(nil)
+	_ VestingAccount = (*DelayedVestingAccount)(nil)
+	_ VestingAccount = (*PeriodicVestingAccount)(nil)
+)
+
+// lockedCoinsFromVesting returns the vesting coins minus the delegated vesting coins, the vesting
+// coins are delegated first, so only the rest of them is locked.
+func (bva BaseVestingAccount) lockedCoinsFromVesting(vestingCoins sdk.Coins) sdk.Coins {
+	var locked sdk.Coins
+	for _, coin := range vestingCoins {
+		amount := coin.Amount.Sub(sdk.MinInt(coin.Amount, bva.DelegatedVesting.AmountOf(coin.Denom)))
+		if amount.IsPositive() {
+			locked = append(locked, sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return sdk.NewCoins(locked...)
+}
+
+// GetOriginalVesting returns the coins vesting when the account was created
+func (bva BaseVestingAccount) GetOriginalVesting() sdk.Coins {
+	return bva.OriginalVesting
+}
+
+// GetDelegatedFree returns the vested coins which are delegated
+func (bva BaseVestingAccount) GetDelegatedFree() sdk.Coins {
+	return bva.DelegatedFree
+}
+
+// GetDelegatedVesting returns the vesting coins which are delegated
+func (bva BaseVestingAccount) GetDelegatedVesting() sdk.Coins {
+	return bva.DelegatedVesting
+}
+
+// GetEndTime returns the time when all coins are vested
+func (bva BaseVestingAccount) GetEndTime() int64 {
+	return bva.EndTime
+}
+
+// GetVestedCoins returns the coins vested linearly from the start time to the end time
+func (cva ContinuousVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	if blockTime.Unix() <= cva.StartTime {
+		return sdk.NewCoins()
+	}
+	if blockTime.Unix() >= cva.EndTime {
+		return cva.OriginalVesting
+	}
+
+	elapsed := sdk.NewDec(blockTime.Unix() - cva.StartTime)
+	duration := sdk.NewDec(cva.EndTime - cva.StartTime)
+	ratio := elapsed.Quo(duration)
+
+	var vested sdk.Coins
+	for _, coin := range cva.OriginalVesting {
+		vested = append(vested, sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(ratio).RoundInt()))
+	}
+	return sdk.NewCoins(vested...)
+}
+
+// GetVestingCoins returns the original vesting coins not vested at the block time
+func (cva ContinuousVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime))
+}
+
+// LockedCoins returns the vesting coins which are not delegated at the block time
+func (cva ContinuousVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return cva.lockedCoinsFromVesting(cva.GetVestingCoins(blockTime))
+}
+
+// GetStartTime returns the time when the coins start vesting
+func (cva ContinuousVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// GetVestedCoins returns all original vesting coins once the end time is reached
+func (dva DelayedVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	if blockTime.Unix() >= dva.EndTime {
+		return dva.OriginalVesting
+	}
+	return sdk.NewCoins()
+}
+
+// GetVestingCoins returns the original vesting coins not vested at the block time
+func (dva DelayedVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return dva.OriginalVesting.Sub(dva.GetVestedCoins(blockTime))
+}
+
+// LockedCoins returns the vesting coins which are not delegated at the block time
+func (dva DelayedVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return dva.lockedCoinsFromVesting(dva.GetVestingCoins(blockTime))
+}
+
+// GetStartTime returns 0, a delayed vesting account has no start time
+func (dva DelayedVestingAccount) GetStartTime() int64 {
+	return 0
+}
+
+// GetVestedCoins returns the amounts of the periods ended at the block time
+func (pva PeriodicVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	if blockTime.Unix() <= pva.StartTime {
+		return sdk.NewCoins()
+	}
+	if blockTime.Unix() >= pva.EndTime {
+		return pva.OriginalVesting
+	}
+
+	vested := sdk.NewCoins()
+	periodEnd := pva.StartTime
+	for _, period := range pva.VestingPeriods {
+		periodEnd += period.Length
+		if blockTime.Unix() < periodEnd {
+			break
+		}
+		vested = vested.Add(period.Amount...)
+	}
+	return vested
+}
+
+// GetVestingCoins returns the original vesting coins not vested at the block time
+func (pva PeriodicVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return pva.OriginalVesting.Sub(pva.GetVestedCoins(blockTime))
+}
+
+// LockedCoins returns the vesting coins which are not delegated at the block time
+func (pva PeriodicVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return pva.lockedCoinsFromVesting(pva.GetVestingCoins(blockTime))
+}
+
+// GetStartTime returns the time when the first period starts
+func (pva PeriodicVestingAccount) GetStartTime() int64 {
+	return pva.StartTime
+}
+
+// SpendableCoins returns the balances minus the locked coins, the spendable amount of a denom
+// is 0 if the locked amount is more than the balance, e.g. the delegation was slashed
+func SpendableCoins(balances, locked sdk.Coins) sdk.Coins {
+	var spendable sdk.Coins
+	for _, coin := range balances {
+		amount := coin.Amount.Sub(locked.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			spendable = append(spendable, sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return sdk.NewCoins(spendable...)
+}
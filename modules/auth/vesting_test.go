@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	codectypes "github.com/irisnet/irishub-sdk-go/codec/types"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const address = "iaa1qzds87rxyrv4ak9gr2mx9ptjhsqs5thcha0e5n"
+
+func newBaseVestingAccount(endTime int64) *BaseVestingAccount {
+	return &BaseVestingAccount{
+		BaseAccount:     &BaseAccount{Address: address, AccountNumber: 3, Sequence: 5},
+		OriginalVesting: sdk.NewCoins(sdk.NewInt64Coin("uiris", 1000)),
+		EndTime:         endTime,
+	}
+}
+
+func TestContinuousVestingAccount(t *testing.T) {
+	start := time.Unix(1000, 0)
+	acc := ContinuousVestingAccount{
+		BaseVestingAccount: newBaseVestingAccount(2000),
+		StartTime:          start.Unix(),
+	}
+
+	require.True(t, acc.GetVestedCoins(start).Empty())
+	require.Equal(t, acc.OriginalVesting, acc.GetVestedCoins(start.Add(2000*time.Second)))
+
+	// a quarter of the coins is vested, 100 of the vesting coins are delegated
+	blockTime := start.Add(250 * time.Second)
+	acc.DelegatedVesting = sdk.NewCoins(sdk.NewInt64Coin("uiris", 100))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uiris", 250)), acc.GetVestedCoins(blockTime))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uiris", 750)), acc.GetVestingCoins(blockTime))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uiris", 650)), acc.LockedCoins(blockTime))
+
+	// the delegated vesting coins are more than the vesting coins
+	acc.DelegatedVesting = sdk.NewCoins(sdk.NewInt64Coin("uiris", 900))
+	require.True(t, acc.LockedCoins(blockTime).Empty())
+}
+
+func TestDelayedVestingAccount(t *testing.T) {
+	acc := DelayedVestingAccount{
+		BaseVestingAccount: newBaseVestingAccount(2000),
+	}
+
+	require.True(t, acc.GetVestedCoins(time.Unix(1999, 0)).Empty())
+	require.Equal(t, acc.OriginalVesting, acc.LockedCoins(time.Unix(1999, 0)))
+	require.Equal(t, acc.OriginalVesting, acc.GetVestedCoins(time.Unix(2000, 0)))
+	require.True(t, acc.LockedCoins(time.Unix(2000, 0)).Empty())
+}
+
+func TestPeriodicVestingAccount(t *testing.T) {
+	acc := PeriodicVestingAccount{
+		BaseVestingAccount: newBaseVestingAccount(1000 + 300),
+		StartTime:          1000,
+		VestingPeriods: []Period{
+			{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("uiris", 500))},
+			{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("uiris", 300))},
+			{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("uiris", 200))},
+		},
+	}
+
+	require.True(t, acc.GetVestedCoins(time.Unix(1099, 0)).Empty())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uiris", 500)), acc.GetVestedCoins(time.Unix(1100, 0)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uiris", 800)), acc.GetVestedCoins(time.Unix(1250, 0)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uiris", 200)), acc.LockedCoins(time.Unix(1250, 0)))
+	require.Equal(t, acc.OriginalVesting, acc.GetVestedCoins(time.Unix(1300, 0)))
+}
+
+func TestSpendableCoins(t *testing.T) {
+	balances := sdk.NewCoins(sdk.NewInt64Coin("uiris", 1000), sdk.NewInt64Coin("ubtc", 10))
+	locked := sdk.NewCoins(sdk.NewInt64Coin("uiris", 400), sdk.NewInt64Coin("ubtc", 20))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uiris", 600)), SpendableCoins(balances, locked))
+}
+
+func TestQueryAccountResp(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	any, err := codectypes.NewAnyWithValue(&PeriodicVestingAccount{
+		BaseVestingAccount: newBaseVestingAccount(1100),
+		StartTime:          1000,
+		VestingPeriods: []Period{
+			{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("uiris", 1000))},
+		},
+	})
+	require.NoError(t, err)
+
+	bz, err := cdc.MarshalBinaryBare(any)
+	require.NoError(t, err)
+	var decoded codectypes.Any
+	require.NoError(t, cdc.UnmarshalBinaryBare(bz, &decoded))
+
+	var acc Account
+	require.NoError(t, cdc.UnpackAny(&decoded, &acc))
+
+	baseAccount, err := GetBaseAccount(acc)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), baseAccount.Sequence)
+
+	resp, err := newQueryAccountResp(cdc, acc, time.Unix(1050, 0))
+	require.NoError(t, err)
+	require.Equal(t, PeriodicVestingAccountType, resp.Type)
+	require.Equal(t, address, resp.Address)
+	require.Equal(t, uint64(3), resp.AccountNumber)
+	require.Equal(t, time.Unix(1000, 0).UTC(), resp.Vesting.StartTime)
+	require.Equal(t, 100*time.Second, resp.Vesting.Periods[0].Length)
+	require.True(t, resp.Vesting.Vested.Empty())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uiris", 1000)), resp.Locked)
+
+	resp, err = newQueryAccountResp(cdc, &ModuleAccount{
+		BaseAccount: &BaseAccount{Address: address},
+		Name:        "distribution",
+	}, time.Unix(1050, 0))
+	require.NoError(t, err)
+	require.Equal(t, ModuleAccountType, resp.Type)
+	require.Equal(t, "distribution", resp.Module.Name)
+	require.Nil(t, resp.Vesting)
+}
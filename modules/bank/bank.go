@@ -1,6 +1,7 @@
 package bank
 
 import (
+	"context"
 	"fmt"
 	"github.com/irisnet/irishub-sdk-go/utils"
 	"strings"
@@ -40,6 +41,114 @@ func (b bankClient) QueryAccount(address string) (sdk.BaseAccount, sdk.Error) {
 	return account, nil
 }
 
+// QueryBalance returns the balance of the denom, the denom can be the symbol or the min unit of the token
+func (b bankClient) QueryBalance(address, denom string) (sdk.DecCoin, sdk.Error) {
+	token, err := b.QueryToken(denom)
+	if err == nil {
+		denom = token.MinUnit
+	}
+
+	conn, err := b.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return sdk.DecCoin{}, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).Balance(
+		context.Background(),
+		&QueryBalanceRequest{
+			Address: address,
+			Denom:   denom,
+		},
+	)
+	if err != nil {
+		return sdk.DecCoin{}, sdk.Wrap(err)
+	}
+
+	balance := sdk.NewCoin(denom, sdk.ZeroInt())
+	if res.Balance != nil {
+		balance = *res.Balance
+	}
+	return b.toMainCoins(balance)[0], nil
+}
+
+func (b bankClient) QueryTotalSupply() (sdk.DecCoins, sdk.Error) {
+	conn, err := b.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).TotalSupply(
+		context.Background(),
+		&QueryTotalSupplyRequest{},
+	)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+	return b.toMainCoins(res.Supply...).Sort(), nil
+}
+
+// QuerySupplyOf returns the supply of the denom, the denom can be the symbol or the min unit of the token
+func (b bankClient) QuerySupplyOf(denom string) (sdk.DecCoin, sdk.Error) {
+	token, err := b.QueryToken(denom)
+	if err == nil {
+		denom = token.MinUnit
+	}
+
+	conn, err := b.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return sdk.DecCoin{}, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).SupplyOf(
+		context.Background(),
+		&QuerySupplyOfRequest{
+			Denom: denom,
+		},
+	)
+	if err != nil {
+		return sdk.DecCoin{}, sdk.Wrap(err)
+	}
+	return b.toMainCoins(res.Amount)[0], nil
+}
+
+func (b bankClient) QueryParams() (QueryParamsResp, sdk.Error) {
+	res, err := b.QueryParamsResponse(context.Background())
+	if err != nil {
+		return QueryParamsResp{}, sdk.Wrap(err)
+	}
+	return res.Convert().(QueryParamsResp), nil
+}
+
+// QueryParamsResponse returns the grpc response of the params at the height of the context
+func (b bankClient) QueryParamsResponse(ctx context.Context) (sdk.Response, error) {
+	conn, err := b.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewQueryClient(conn).Params(ctx, &QueryParamsRequest{})
+}
+
+// toMainCoins converts the coins to the main unit of their tokens, the coins which are not
+// tokens of the token module, like the liquidity of coinswap, are kept in their min unit
+func (b bankClient) toMainCoins(coins ...sdk.Coin) sdk.DecCoins {
+	mainCoins := make(sdk.DecCoins, len(coins))
+	for i, coin := range coins {
+		mainCoin, err := b.ToMainCoin(coin)
+		if err != nil {
+			b.Logger().Debug("keep the coin in the min unit", "denom", coin.Denom, "errMsg", err.Error())
+			mainCoins[i] = sdk.NewDecCoinFromCoin(coin)
+			continue
+		}
+		mainCoins[i] = mainCoin[0]
+	}
+	return mainCoins
+}
+
 // Send is responsible for transferring tokens from `From` to `to` account
 func (b bankClient) Send(to string, amount sdk.DecCoins, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
 	sender, err := b.QueryAddress(baseTx.From, baseTx.Password)
@@ -14,6 +14,12 @@ type Client interface {
 	SubscribeSendTx(from, to string, callback EventMsgSendCallback) sdk.Subscription
 
 	QueryAccount(address string) (sdk.BaseAccount, sdk.Error)
+	// QueryBalance returns the balance of the denom in the main unit of the token
+	QueryBalance(address, denom string) (sdk.DecCoin, sdk.Error)
+	// QueryTotalSupply returns the supply of all tokens in their main unit
+	QueryTotalSupply() (sdk.DecCoins, sdk.Error)
+	QuerySupplyOf(denom string) (sdk.DecCoin, sdk.Error)
+	QueryParams() (QueryParamsResp, sdk.Error)
 }
 
 type Receipt struct {
@@ -42,3 +48,13 @@ type EventDataMsgSend struct {
 }
 
 type EventMsgSendCallback func(EventDataMsgSend)
+
+type QueryParamsResp struct {
+	SendEnabled        []SendEnabledResp `json:"send_enabled"`
+	DefaultSendEnabled bool              `json:"default_send_enabled"`
+}
+
+type SendEnabledResp struct {
+	Denom   string `json:"denom"`
+	Enabled bool   `json:"enabled"`
+}
@@ -217,3 +217,18 @@ func ValidateInputsOutputs(inputs []Input, outputs []Output) error {
 
 	return nil
 }
+
+func (q QueryParamsResponse) Convert() interface{} {
+	var sendEnabled []SendEnabledResp
+	for _, se := range q.Params.SendEnabled {
+		sendEnabled = append(sendEnabled, SendEnabledResp{
+			Denom:   se.Denom,
+			Enabled: se.Enabled,
+		})
+	}
+
+	return QueryParamsResp{
+		SendEnabled:        sendEnabled,
+		DefaultSendEnabled: q.Params.DefaultSendEnabled,
+	}
+}
@@ -40,6 +40,7 @@ type baseClient struct {
 	cfg            *sdk.ClientConfig
 	encodingConfig sdk.EncodingConfig
 	l              *locker
+	light          *lightClient
 
 	accountQuery
 	tokenQuery
@@ -56,17 +57,34 @@ func NewBaseClient(cfg sdk.ClientConfig, encodingConfig sdk.EncodingConfig, logg
 	}
 
 	base := baseClient{
-		TmClient:       NewRPCClient(cfg.NodeURI, encodingConfig.Amino, encodingConfig.TxConfig.TxDecoder(), logger, cfg.Timeout),
-		GRPCClient:     NewGRPCClient(cfg.GRPCAddr),
+		TmClient:       cfg.TmClient,
+		GRPCClient:     cfg.GRPCClient,
 		logger:         logger,
 		cfg:            &cfg,
 		encodingConfig: encodingConfig,
 		l:              NewLocker(concurrency),
 	}
 
-	base.KeyManager = keyManager{
-		keyDAO: cfg.KeyDAO,
-		algo:   cfg.Algo,
+	if base.TmClient == nil {
+		base.TmClient = NewRPCClient(cfg.NodeURI, encodingConfig.Amino, encodingConfig.TxConfig.TxDecoder(), logger, cfg.Timeout)
+	}
+	base.light = newLightClient(cfg, base.TmClient, logger)
+
+	if base.GRPCClient == nil {
+		switch cfg.Transport {
+		case sdk.REST:
+			base.GRPCClient = NewRESTClient(cfg.LCDAddr, encodingConfig.Marshaler, cfg.Timeout)
+		default:
+			base.GRPCClient = NewGRPCClient(cfg.GRPCAddr)
+		}
+	}
+
+	base.KeyManager = cfg.KeyManager
+	if base.KeyManager == nil {
+		base.KeyManager = keyManager{
+			keyDAO: cfg.KeyDAO,
+			algo:   cfg.Algo,
+		}
 	}
 
 	c := cache.NewCache(cacheCapacity, cfg.Cached)
@@ -128,6 +146,26 @@ func (base *baseClient) BuildAndSendWithAccount(addr string, accountNumber, sequ
 	return base.broadcastTx(txByte, ctx.Mode(), baseTx.Simulate)
 }
 
+// BuildUnsignedTx returns the JSON of the unsigned transaction sent by baseTx.From, the sender
+// can be a watch-only or multisig key whose signatures are added later
+func (base *baseClient) BuildUnsignedTx(msgs []sdk.Msg, baseTx sdk.BaseTx) ([]byte, sdk.Error) {
+	builder, err := base.prepare(baseTx)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	tx, err := builder.BuildUnsignedTx(msgs)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	bz, err := base.encodingConfig.TxConfig.TxJSONEncoder()(tx.GetTx())
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+	return bz, nil
+}
+
 func (base *baseClient) SendBatch(msgs sdk.Msgs, baseTx sdk.BaseTx) (rs []sdk.ResultTx, err sdk.Error) {
 	if msgs == nil || len(msgs) == 0 {
 		return rs, sdk.Wrapf("must have at least one message in list")
@@ -253,6 +291,62 @@ func (base baseClient) QueryStore(key sdk.HexBytes, storeName string, height int
 	return resp, nil
 }
 
+// VerifiedQueryStore queries the value of `key` in the module store `storeName` and verifies
+// the returned proof against the app hash committed in the header at height+1. The header is
+// verified by the light client, an error is returned if the light client is not enabled since
+// the app hash of the queried node would only prove that the node is consistent with itself.
+func (base baseClient) VerifiedQueryStore(key sdk.HexBytes, storeName string, height int64) (sdk.ProofResult, error) {
+	if base.cfg.LightClient == nil {
+		return sdk.ProofResult{}, errLightClientDisabled
+	}
+
+	if height <= 0 {
+		// the app hash of the latest state is only known once the next block is committed
+		status, err := base.Status(context.Background())
+		if err != nil {
+			return sdk.ProofResult{}, err
+		}
+		height = status.SyncInfo.LatestBlockHeight - 1
+	}
+
+	res, err := base.QueryStore(key, storeName, height, true)
+	if err != nil {
+		return sdk.ProofResult{}, err
+	}
+
+	appHash, err := base.appHash(res.Height + 1)
+	if err != nil {
+		return sdk.ProofResult{}, err
+	}
+
+	if err := sdk.VerifyProof(res.ProofOps, appHash, storeName, key, res.Value); err != nil {
+		return sdk.ProofResult{}, err
+	}
+
+	return sdk.ProofResult{
+		StoreName: storeName,
+		Key:       key,
+		Value:     res.Value,
+		Exists:    len(res.Value) > 0,
+		Height:    res.Height,
+		AppHash:   appHash,
+	}, nil
+}
+
+// QueryVerifiedHeader returns the header at the given height (0 for the latest) verified by the light client
+func (base baseClient) QueryVerifiedHeader(height int64) (*sdk.SignedHeader, error) {
+	return base.light.QueryVerifiedHeader(height)
+}
+
+// appHash returns the app hash of the header at the given height verified by the light client
+func (base baseClient) appHash(height int64) (sdk.HexBytes, error) {
+	header, err := base.QueryVerifiedHeader(height)
+	if err != nil {
+		return nil, err
+	}
+	return header.AppHash, nil
+}
+
 func (base *baseClient) prepare(baseTx sdk.BaseTx) (*clienttx.Factory, error) {
 	factory := clienttx.NewFactory().
 		WithChainID(base.cfg.ChainID).
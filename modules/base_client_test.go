@@ -0,0 +1,97 @@
+package modules
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	ics23 "github.com/irisnet/irishub-sdk-go/third_party/github.com/confio/ics23/go"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+func TestVerifiedQueryStore(t *testing.T) {
+	storeName, key, value := "record", []byte("key"), []byte("value")
+	proofOps, appHash := storeProof(t, storeName, key, value)
+
+	// the state queried at height 2 is committed by the header at height 3
+	node := newMockNode(t, nil, nil, appHash)
+	node.query = func(_ string, _ tmbytes.HexBytes, height int64) abci.ResponseQuery {
+		return abci.ResponseQuery{Key: key, Value: value, ProofOps: proofOps, Height: height}
+	}
+
+	res, err := newMockClient(t, node, true).VerifiedQueryStore(key, storeName, 2)
+	require.NoError(t, err)
+	require.True(t, res.Exists)
+	require.Equal(t, sdk.HexBytes(value), res.Value)
+	require.Equal(t, sdk.HexBytes(appHash), res.AppHash)
+
+	// the latest state is the state committed by the latest header
+	res, err = newMockClient(t, node, true).VerifiedQueryStore(key, storeName, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), res.Height)
+
+	// the proof does not match the value
+	node.query = func(_ string, _ tmbytes.HexBytes, height int64) abci.ResponseQuery {
+		return abci.ResponseQuery{Key: key, Value: []byte("fake"), ProofOps: proofOps, Height: height}
+	}
+	_, err = newMockClient(t, node, true).VerifiedQueryStore(key, storeName, 2)
+	require.Error(t, err)
+
+	// the app hash of the header is replaced by the node
+	node = newMockNode(t, nil, nil, bytes.Repeat([]byte{1}, 32))
+	node.query = func(_ string, _ tmbytes.HexBytes, height int64) abci.ResponseQuery {
+		return abci.ResponseQuery{Key: key, Value: value, ProofOps: proofOps, Height: height}
+	}
+	node.tamper(3, func(header *tmtypes.Header) { header.AppHash = appHash })
+	_, err = newMockClient(t, node, true).VerifiedQueryStore(key, storeName, 2)
+	require.Error(t, err)
+
+	// the app hash of an unverified header proves nothing
+	_, err = newMockClient(t, node, false).VerifiedQueryStore(key, storeName, 2)
+	require.Equal(t, errLightClientDisabled, err)
+}
+
+// storeProof returns the proof of the key in a module store of a multistore and the root of the multistore
+func storeProof(t *testing.T, storeName string, key, value []byte) (*crypto.ProofOps, []byte) {
+	proof := &ics23.ExistenceProof{
+		Key:   key,
+		Value: value,
+		Leaf:  ics23.IavlSpec.LeafSpec,
+		Path: []*ics23.InnerOp{{
+			Hash:   ics23.HashOp_SHA256,
+			Prefix: []byte{2, 4, 2, 32},
+			Suffix: append([]byte{32}, bytes.Repeat([]byte{1}, 32)...),
+		}},
+	}
+	storeRoot, err := proof.Calculate()
+	require.NoError(t, err)
+
+	multiStoreProof := &ics23.ExistenceProof{
+		Key:   []byte(storeName),
+		Value: storeRoot,
+		Leaf:  ics23.TendermintSpec.LeafSpec,
+		Path: []*ics23.InnerOp{{
+			Hash:   ics23.HashOp_SHA256,
+			Prefix: []byte{1},
+			Suffix: bytes.Repeat([]byte{2}, 32),
+		}},
+	}
+	appHash, err := multiStoreProof.Calculate()
+	require.NoError(t, err)
+
+	return &crypto.ProofOps{Ops: []crypto.ProofOp{
+		proofOp(t, sdk.ProofOpIAVLCommitment, key, proof),
+		proofOp(t, sdk.ProofOpSimpleMerkleCommitment, []byte(storeName), multiStoreProof),
+	}}, appHash
+}
+
+func proofOp(t *testing.T, typ string, key []byte, proof *ics23.ExistenceProof) crypto.ProofOp {
+	bz, err := (&ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Exist{Exist: proof}}).Marshal()
+	require.NoError(t, err)
+	return crypto.ProofOp{Type: typ, Key: key, Data: bz}
+}
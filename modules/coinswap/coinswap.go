@@ -0,0 +1,204 @@
+package coinswap
+
+import (
+	"context"
+	"time"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+type coinswapClient struct {
+	sdk.BaseClient
+	codec.Marshaler
+}
+
+func NewClient(baseClient sdk.BaseClient, marshaler codec.Marshaler) Client {
+	return &coinswapClient{
+		BaseClient: baseClient,
+		Marshaler:  marshaler,
+	}
+}
+
+func (cc coinswapClient) Name() string {
+	return ModuleName
+}
+
+func (cc coinswapClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
+	RegisterInterfaces(registry)
+}
+
+func (cc coinswapClient) AddLiquidity(request AddLiquidityRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	sender, err := cc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	minLiquidity := request.MinLiquidity
+	if minLiquidity.IsNil() {
+		minLiquidity = sdk.ZeroInt()
+	}
+
+	msg := &MsgAddLiquidity{
+		MaxToken:         request.MaxToken,
+		ExactStandardAmt: request.ExactStandardAmt,
+		MinLiquidity:     minLiquidity,
+		Deadline:         deadline(request.Deadline),
+		Sender:           sender.String(),
+	}
+	return cc.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+func (cc coinswapClient) RemoveLiquidity(request RemoveLiquidityRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	sender, err := cc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	minToken, minStandardAmt := request.MinToken, request.MinStandardAmt
+	if minToken.IsNil() {
+		minToken = sdk.ZeroInt()
+	}
+	if minStandardAmt.IsNil() {
+		minStandardAmt = sdk.ZeroInt()
+	}
+
+	msg := &MsgRemoveLiquidity{
+		WithdrawLiquidity: request.WithdrawLiquidity,
+		MinToken:          minToken,
+		MinStandardAmt:    minStandardAmt,
+		Deadline:          deadline(request.Deadline),
+		Sender:            sender.String(),
+	}
+	return cc.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+func (cc coinswapClient) Buy(request BuyRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	sender, err := cc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	recipient := request.Recipient
+	if len(recipient) == 0 {
+		recipient = sender.String()
+	}
+
+	msg := &MsgSwapOrder{
+		Input: Input{
+			Address: sender.String(),
+			Coin:    request.MaxInput,
+		},
+		Output: Output{
+			Address: recipient,
+			Coin:    request.Output,
+		},
+		Deadline:   deadline(request.Deadline),
+		IsBuyOrder: true,
+	}
+	return cc.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+func (cc coinswapClient) Sell(request SellRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	sender, err := cc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	recipient := request.Recipient
+	if len(recipient) == 0 {
+		recipient = sender.String()
+	}
+
+	msg := &MsgSwapOrder{
+		Input: Input{
+			Address: sender.String(),
+			Coin:    request.Input,
+		},
+		Output: Output{
+			Address: recipient,
+			Coin:    request.MinOutput,
+		},
+		Deadline:   deadline(request.Deadline),
+		IsBuyOrder: false,
+	}
+	return cc.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+func (cc coinswapClient) QueryPool(denom string) (QueryPoolResp, sdk.Error) {
+	conn, err := cc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return QueryPoolResp{}, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).Liquidity(
+		context.Background(),
+		&QueryLiquidityRequest{
+			Denom: denom,
+		},
+	)
+	if err != nil {
+		return QueryPoolResp{}, sdk.Wrap(err)
+	}
+
+	pool, err := res.pool()
+	if err != nil {
+		return QueryPoolResp{}, sdk.Wrap(err)
+	}
+	return pool, nil
+}
+
+func (cc coinswapClient) QuoteBuy(output sdk.Coin, inputDenom string) (Quote, sdk.Error) {
+	route, err := cc.route(inputDenom, output.Denom)
+	if err != nil {
+		return Quote{}, err
+	}
+	return quoteBuy(route, output)
+}
+
+func (cc coinswapClient) QuoteSell(input sdk.Coin, outputDenom string) (Quote, sdk.Error) {
+	route, err := cc.route(input.Denom, outputDenom)
+	if err != nil {
+		return Quote{}, err
+	}
+	return quoteSell(route, input)
+}
+
+// route returns the pools swapping the input for the output, the input is swapped for the
+// standard token first if none of them is the standard token
+func (cc coinswapClient) route(inputDenom, outputDenom string) ([]hop, sdk.Error) {
+	if inputDenom == outputDenom {
+		return nil, sdk.Wrapf("input and output denomination are equal")
+	}
+
+	inputPool, err := cc.QueryPool(inputDenom)
+	if err != nil {
+		// the input is the standard token which has no pool
+		outputPool, e := cc.QueryPool(outputDenom)
+		if e != nil || outputPool.Standard.Denom != inputDenom {
+			return nil, err
+		}
+		return []hop{{outputPool.Standard, outputPool.Token, outputPool.Fee}}, nil
+	}
+
+	route := []hop{{inputPool.Token, inputPool.Standard, inputPool.Fee}}
+	if inputPool.Standard.Denom == outputDenom {
+		return route, nil
+	}
+
+	outputPool, err := cc.QueryPool(outputDenom)
+	if err != nil {
+		return nil, err
+	}
+	return append(route, hop{outputPool.Standard, outputPool.Token, outputPool.Fee}), nil
+}
+
+// deadline returns the unix time of the deadline, DefaultDeadline from now if not set
+func deadline(t time.Time) int64 {
+	if t.IsZero() {
+		t = time.Now().Add(DefaultDeadline)
+	}
+	return t.Unix()
+}
@@ -0,0 +1,80 @@
+package coinswap
+
+import (
+	"time"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// expose Coinswap module api for user, all amounts are in the min unit of the tokens
+type Client interface {
+	sdk.Module
+
+	AddLiquidity(request AddLiquidityRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+	RemoveLiquidity(request RemoveLiquidityRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+	// Buy buys the exact output by paying at most the max input
+	Buy(request BuyRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+	// Sell sells the exact input for at least the min output
+	Sell(request SellRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+
+	// QueryPool returns the reserves and the liquidity token supply of the pool of the token
+	QueryPool(denom string) (QueryPoolResp, sdk.Error)
+	// QuoteBuy estimates the input to pay for the exact output with the current reserves
+	QuoteBuy(output sdk.Coin, inputDenom string) (Quote, sdk.Error)
+	// QuoteSell estimates the output bought by the exact input with the current reserves
+	QuoteSell(input sdk.Coin, outputDenom string) (Quote, sdk.Error)
+}
+
+// DefaultDeadline is the deadline of the requests without one, counted from now
+const DefaultDeadline = 5 * time.Minute
+
+type AddLiquidityRequest struct {
+	// MaxToken is the max amount of the token deposited with the standard token
+	MaxToken         sdk.Coin  `json:"max_token"`
+	ExactStandardAmt sdk.Int   `json:"exact_standard_amt"`
+	MinLiquidity     sdk.Int   `json:"min_liquidity"`
+	Deadline         time.Time `json:"deadline"`
+}
+
+type RemoveLiquidityRequest struct {
+	WithdrawLiquidity sdk.Coin  `json:"withdraw_liquidity"`
+	MinToken          sdk.Int   `json:"min_token"`
+	MinStandardAmt    sdk.Int   `json:"min_standard_amt"`
+	Deadline          time.Time `json:"deadline"`
+}
+
+type BuyRequest struct {
+	MaxInput sdk.Coin `json:"max_input"`
+	Output   sdk.Coin `json:"output"`
+	// Recipient receives the output, the sender if empty
+	Recipient string    `json:"recipient"`
+	Deadline  time.Time `json:"deadline"`
+}
+
+type SellRequest struct {
+	Input     sdk.Coin `json:"input"`
+	MinOutput sdk.Coin `json:"min_output"`
+	// Recipient receives the output, the sender if empty
+	Recipient string    `json:"recipient"`
+	Deadline  time.Time `json:"deadline"`
+}
+
+type QueryPoolResp struct {
+	Standard  sdk.Coin `json:"standard"`
+	Token     sdk.Coin `json:"token"`
+	Liquidity sdk.Coin `json:"liquidity"`
+	Fee       sdk.Dec  `json:"fee"`
+}
+
+// Quote is the estimated result of a swap, the swap through two pools when none of the
+// tokens is the standard token pays the fee twice
+type Quote struct {
+	Input  sdk.Coin `json:"input"`
+	Output sdk.Coin `json:"output"`
+	// Price is the input paid per unit of the output
+	Price sdk.Dec `json:"price"`
+	// SpotPrice is the input per unit of the output of the current reserves without fee
+	SpotPrice sdk.Dec `json:"spot_price"`
+	// Slippage is the rate Price is above SpotPrice, including the fee
+	Slippage sdk.Dec `json:"slippage"`
+}
@@ -0,0 +1,117 @@
+package coinswap
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// GetInputPrice returns the amount of the output bought by the exact input, the fee is
+// charged from the input, the same as the coinswap module of irishub
+func GetInputPrice(inputAmt, inputReserve, outputReserve sdk.Int, fee sdk.Dec) sdk.Int {
+	deltaFee := sdk.OneDec().Sub(fee)
+	inputAmtWithFee := inputAmt.Mul(sdk.NewIntFromBigInt(deltaFee.BigInt()))
+	numerator := inputAmtWithFee.Mul(outputReserve)
+	denominator := inputReserve.Mul(sdk.NewIntWithDecimal(1, sdk.Precision)).Add(inputAmtWithFee)
+	return numerator.Quo(denominator)
+}
+
+// GetOutputPrice returns the amount of the input paid for the exact output, the fee is
+// charged from the input, the same as the coinswap module of irishub
+func GetOutputPrice(outputAmt, inputReserve, outputReserve sdk.Int, fee sdk.Dec) sdk.Int {
+	deltaFee := sdk.OneDec().Sub(fee)
+	numerator := inputReserve.Mul(outputAmt).Mul(sdk.NewIntWithDecimal(1, sdk.Precision))
+	denominator := (outputReserve.Sub(outputAmt)).Mul(sdk.NewIntFromBigInt(deltaFee.BigInt()))
+	return numerator.Quo(denominator).Add(sdk.OneInt())
+}
+
+// MaxInput returns the input of a buy order accepting the price to rise by the tolerance
+func (q Quote) MaxInput(tolerance sdk.Dec) sdk.Coin {
+	amount := q.Input.Amount.ToDec().Mul(sdk.OneDec().Add(tolerance)).Ceil().TruncateInt()
+	return sdk.NewCoin(q.Input.Denom, amount)
+}
+
+// MinOutput returns the output of a sell order accepting the price to rise by the tolerance
+func (q Quote) MinOutput(tolerance sdk.Dec) sdk.Coin {
+	amount := q.Output.Amount.ToDec().Quo(sdk.OneDec().Add(tolerance)).TruncateInt()
+	return sdk.NewCoin(q.Output.Denom, amount)
+}
+
+// hop is a swap through a pool, from the reserve of the input to the reserve of the output
+type hop struct {
+	inputReserve  sdk.Coin
+	outputReserve sdk.Coin
+	fee           sdk.Dec
+}
+
+func (h hop) validate() sdk.Error {
+	if !h.inputReserve.Amount.IsPositive() {
+		return sdk.Wrapf("reserve pool insufficient funds, actual [%s]", h.inputReserve.String())
+	}
+	if !h.outputReserve.Amount.IsPositive() {
+		return sdk.Wrapf("reserve pool insufficient funds, actual [%s]", h.outputReserve.String())
+	}
+	return nil
+}
+
+// quoteSell swaps the exact input through the pools of the route
+func quoteSell(route []hop, input sdk.Coin) (Quote, sdk.Error) {
+	if !input.Amount.IsPositive() {
+		return Quote{}, sdk.Wrapf("the input %s must be positive", input.String())
+	}
+
+	amount := input.Amount
+	for _, h := range route {
+		if err := h.validate(); err != nil {
+			return Quote{}, err
+		}
+		amount = GetInputPrice(amount, h.inputReserve.Amount, h.outputReserve.Amount, h.fee)
+	}
+
+	output := sdk.NewCoin(route[len(route)-1].outputReserve.Denom, amount)
+	if !output.IsPositive() {
+		return Quote{}, sdk.Wrapf("%s is too little to buy any %s", input.String(), output.Denom)
+	}
+	return newQuote(route, input, output), nil
+}
+
+// quoteBuy swaps for the exact output through the pools of the route
+func quoteBuy(route []hop, output sdk.Coin) (Quote, sdk.Error) {
+	if !output.Amount.IsPositive() {
+		return Quote{}, sdk.Wrapf("the output %s must be positive", output.String())
+	}
+
+	amount := output.Amount
+	for i := len(route) - 1; i >= 0; i-- {
+		h := route[i]
+		if err := h.validate(); err != nil {
+			return Quote{}, err
+		}
+		if amount.GTE(h.outputReserve.Amount) {
+			return Quote{}, sdk.Wrapf("reserve pool insufficient balance of %s, expected: %s, actual: %s",
+				h.outputReserve.Denom, amount.String(), h.outputReserve.Amount.String())
+		}
+		amount = GetOutputPrice(amount, h.inputReserve.Amount, h.outputReserve.Amount, h.fee)
+	}
+
+	input := sdk.NewCoin(route[0].inputReserve.Denom, amount)
+	return newQuote(route, input, output), nil
+}
+
+func newQuote(route []hop, input, output sdk.Coin) Quote {
+	spotPrice := sdk.OneDec()
+	for _, h := range route {
+		spotPrice = spotPrice.Mul(h.inputReserve.Amount.ToDec()).Quo(h.outputReserve.Amount.ToDec())
+	}
+
+	price := input.Amount.ToDec().Quo(output.Amount.ToDec())
+	slippage := sdk.ZeroDec()
+	if spotPrice.IsPositive() {
+		slippage = price.Quo(spotPrice).Sub(sdk.OneDec())
+	}
+	return Quote{
+		Input:     input,
+		Output:    output,
+		Price:     price,
+		SpotPrice: spotPrice,
+		Slippage:  slippage,
+	}
+}
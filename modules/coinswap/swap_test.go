@@ -0,0 +1,93 @@
+package coinswap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+func TestPrice(t *testing.T) {
+	fee := sdk.NewDecWithPrec(3, 3)
+	reserve := sdk.NewInt(1000000)
+
+	require.Equal(t, sdk.NewInt(996), GetInputPrice(sdk.NewInt(1000), reserve, reserve, fee))
+	require.Equal(t, sdk.NewInt(1000), GetOutputPrice(sdk.NewInt(996), reserve, reserve, fee))
+}
+
+func TestQuote(t *testing.T) {
+	fee := sdk.NewDecWithPrec(3, 3)
+	pool := hop{
+		inputReserve:  sdk.NewCoin("uiris", sdk.NewInt(2000000)),
+		outputReserve: sdk.NewCoin("btc", sdk.NewInt(1000000)),
+		fee:           fee,
+	}
+
+	quote, err := quoteSell([]hop{pool}, sdk.NewCoin("uiris", sdk.NewInt(2000)))
+	require.NoError(t, err)
+	require.Equal(t, "btc", quote.Output.Denom)
+	require.Equal(t, sdk.NewInt(996), quote.Output.Amount)
+	require.Equal(t, sdk.NewDec(2), quote.SpotPrice)
+	require.True(t, quote.Slippage.IsPositive())
+	require.True(t, quote.Slippage.LT(sdk.NewDecWithPrec(1, 2)))
+
+	buy, err := quoteBuy([]hop{pool}, quote.Output)
+	require.NoError(t, err)
+	require.Equal(t, "uiris", buy.Input.Denom)
+	require.True(t, buy.Input.Amount.LTE(quote.Input.Amount))
+
+	require.Equal(t, sdk.NewInt(986), quote.MinOutput(sdk.NewDecWithPrec(1, 2)).Amount)
+	require.Equal(t, sdk.NewInt(2020), quote.MaxInput(sdk.NewDecWithPrec(1, 2)).Amount)
+
+	// the output can not drain the pool
+	_, err = quoteBuy([]hop{pool}, sdk.NewCoin("btc", sdk.NewInt(1000000)))
+	require.Error(t, err)
+
+	// the swap between two tokens pays the fee twice
+	eth := hop{
+		inputReserve:  sdk.NewCoin("uiris", sdk.NewInt(3000000)),
+		outputReserve: sdk.NewCoin("eth", sdk.NewInt(1000000)),
+		fee:           fee,
+	}
+	route := []hop{{pool.outputReserve, pool.inputReserve, fee}, eth}
+	quote, err = quoteSell(route, sdk.NewCoin("btc", sdk.NewInt(3000)))
+	require.NoError(t, err)
+	require.Equal(t, "eth", quote.Output.Denom)
+	require.Equal(t, sdk.NewDecWithPrec(15, 1), quote.SpotPrice)
+	require.True(t, quote.Slippage.GT(sdk.NewDecWithPrec(6, 3)))
+
+	buy, err = quoteBuy(route, quote.Output)
+	require.NoError(t, err)
+	require.Equal(t, "btc", buy.Input.Denom)
+	require.True(t, buy.Input.Amount.LTE(quote.Input.Amount))
+
+	_, err = quoteSell([]hop{{pool.inputReserve, sdk.NewCoin("btc", sdk.ZeroInt()), fee}}, sdk.NewCoin("uiris", sdk.NewInt(1)))
+	require.Error(t, err)
+
+	// the amounts must be positive
+	_, err = quoteBuy([]hop{pool}, sdk.NewCoin("btc", sdk.ZeroInt()))
+	require.Error(t, err)
+	_, err = quoteSell([]hop{pool}, sdk.NewCoin("uiris", sdk.ZeroInt()))
+	require.Error(t, err)
+}
+
+func TestQueryLiquidityResponsePool(t *testing.T) {
+	res := QueryLiquidityResponse{
+		Standard:  sdk.NewCoin("uiris", sdk.NewInt(2000000)),
+		Token:     sdk.NewCoin("btc", sdk.NewInt(1000000)),
+		Liquidity: sdk.NewCoin("swap/btc", sdk.NewInt(2000000)),
+		Fee:       "0.003",
+	}
+	pool, err := res.pool()
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(3, 3), pool.Fee)
+	require.Equal(t, res.Token, pool.Token)
+
+	// the pool is not quoted without a fee in [0, 1)
+	for _, fee := range []string{"", "fee", "-0.1", "1", "1.5"} {
+		res.Fee = fee
+		_, err = res.pool()
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,174 @@
+package coinswap
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	ModuleName = "coinswap"
+
+	// FormatUniABSPrefix is the prefix of the liquidity tokens
+	FormatUniABSPrefix = "swap/"
+	// FormatUniDenom is the denom of the liquidity token of a pool
+	FormatUniDenom = "swap/%s"
+
+	TypeMsgAddLiquidity    = "add_liquidity"
+	TypeMsgRemoveLiquidity = "remove_liquidity"
+	TypeMsgSwapOrder       = "swap_order"
+)
+
+var (
+	_ sdk.Msg = &MsgAddLiquidity{}
+	_ sdk.Msg = &MsgRemoveLiquidity{}
+	_ sdk.Msg = &MsgSwapOrder{}
+)
+
+// GetUniDenomFromDenom returns the denom of the liquidity token of the pool of the token
+func GetUniDenomFromDenom(denom string) string {
+	return fmt.Sprintf(FormatUniDenom, denom)
+}
+
+func (msg MsgSwapOrder) Route() string { return ModuleName }
+
+func (msg MsgSwapOrder) Type() string { return TypeMsgSwapOrder }
+
+func (msg MsgSwapOrder) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Input.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgSwapOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgSwapOrder) ValidateBasic() error {
+	if !(msg.Input.Coin.IsValid() && msg.Input.Coin.IsPositive()) {
+		return sdk.Wrapf("input coin is invalid: %s", msg.Input.Coin.String())
+	}
+	if strings.HasPrefix(msg.Input.Coin.Denom, FormatUniABSPrefix) {
+		return sdk.Wrapf("unsupported input coin type: %s", msg.Input.Coin.String())
+	}
+	if !(msg.Output.Coin.IsValid() && msg.Output.Coin.IsPositive()) {
+		return sdk.Wrapf("output coin is invalid: %s", msg.Output.Coin.String())
+	}
+	if strings.HasPrefix(msg.Output.Coin.Denom, FormatUniABSPrefix) {
+		return sdk.Wrapf("unsupported output coin type: %s", msg.Output.Coin.String())
+	}
+	if msg.Input.Coin.Denom == msg.Output.Coin.Denom {
+		return sdk.Wrapf("input and output denomination are equal")
+	}
+	if msg.Deadline <= 0 {
+		return sdk.Wrapf("deadline %d must be greater than 0", msg.Deadline)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Input.Address); err != nil {
+		return sdk.Wrapf("invalid input address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Output.Address); err != nil {
+		return sdk.Wrapf("invalid output address")
+	}
+	return nil
+}
+
+func (msg MsgAddLiquidity) Route() string { return ModuleName }
+
+func (msg MsgAddLiquidity) Type() string { return TypeMsgAddLiquidity }
+
+func (msg MsgAddLiquidity) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgAddLiquidity) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgAddLiquidity) ValidateBasic() error {
+	if !(msg.MaxToken.IsValid() && msg.MaxToken.IsPositive()) {
+		return sdk.Wrapf("max token is invalid: %s", msg.MaxToken.String())
+	}
+	if strings.HasPrefix(msg.MaxToken.Denom, FormatUniABSPrefix) {
+		return sdk.Wrapf("max token must be non-liquidity token")
+	}
+	if msg.ExactStandardAmt.IsNil() || !msg.ExactStandardAmt.IsPositive() {
+		return sdk.Wrapf("standard token amount must be positive")
+	}
+	if msg.MinLiquidity.IsNil() || msg.MinLiquidity.IsNegative() {
+		return sdk.Wrapf("minimum liquidity can not be negative")
+	}
+	if msg.Deadline <= 0 {
+		return sdk.Wrapf("deadline %d must be greater than 0", msg.Deadline)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdk.Wrapf("invalid sender address")
+	}
+	return nil
+}
+
+func (msg MsgRemoveLiquidity) Route() string { return ModuleName }
+
+func (msg MsgRemoveLiquidity) Type() string { return TypeMsgRemoveLiquidity }
+
+func (msg MsgRemoveLiquidity) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgRemoveLiquidity) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRemoveLiquidity) ValidateBasic() error {
+	if msg.MinToken.IsNil() || msg.MinToken.IsNegative() {
+		return sdk.Wrapf("minimum token amount can not be negative")
+	}
+	if !msg.WithdrawLiquidity.IsValid() || !msg.WithdrawLiquidity.IsPositive() {
+		return sdk.Wrapf("withdraw liquidity %s is not valid", msg.WithdrawLiquidity.String())
+	}
+	if !strings.HasPrefix(msg.WithdrawLiquidity.Denom, FormatUniABSPrefix) {
+		return sdk.Wrapf("invalid liquidity denom: %s", msg.WithdrawLiquidity.Denom)
+	}
+	if msg.MinStandardAmt.IsNil() || msg.MinStandardAmt.IsNegative() {
+		return sdk.Wrapf("minimum standard token amount can not be negative")
+	}
+	if msg.Deadline <= 0 {
+		return sdk.Wrapf("deadline %d must be greater than 0", msg.Deadline)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdk.Wrapf("invalid sender address")
+	}
+	return nil
+}
+
+// pool returns the pool of the response, not use Convert() in order to return the error of an
+// invalid fee rather than quoting the pool without the fee
+func (q QueryLiquidityResponse) pool() (QueryPoolResp, error) {
+	fee, err := sdk.NewDecFromStr(q.Fee)
+	if err != nil {
+		return QueryPoolResp{}, sdk.Wrapf("invalid fee %q of the pool of %s: %s", q.Fee, q.Token.Denom, err.Error())
+	}
+	// the prices divide by 1 - fee
+	if fee.IsNegative() || fee.GTE(sdk.OneDec()) {
+		return QueryPoolResp{}, sdk.Wrapf("invalid fee %s of the pool of %s, expected in [0, 1)", q.Fee, q.Token.Denom)
+	}
+	return QueryPoolResp{
+		Standard:  q.Standard,
+		Token:     q.Token,
+		Liquidity: q.Liquidity,
+		Fee:       fee,
+	}, nil
+}
@@ -0,0 +1,308 @@
+package distribution
+
+import (
+	"context"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/query"
+	"github.com/irisnet/irishub-sdk-go/utils"
+)
+
+type distributionClient struct {
+	sdk.BaseClient
+	codec.Marshaler
+}
+
+func NewClient(baseClient sdk.BaseClient, marshaler codec.Marshaler) Client {
+	return &distributionClient{
+		BaseClient: baseClient,
+		Marshaler:  marshaler,
+	}
+}
+
+func (dc distributionClient) Name() string {
+	return ModuleName
+}
+
+func (dc distributionClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
+	RegisterInterfaces(registry)
+}
+
+func (dc distributionClient) WithdrawRewards(validatorAddr string, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	delegatorAddr, err := dc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msg := &MsgWithdrawDelegatorReward{
+		DelegatorAddress: delegatorAddr.String(),
+		ValidatorAddress: validatorAddr,
+	}
+	return dc.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+func (dc distributionClient) WithdrawAllRewards(baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	delegatorAddr, err := dc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	validators, err := dc.QueryDelegatorValidators(delegatorAddr.String())
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+	if len(validators) == 0 {
+		return sdk.ResultTx{}, sdk.Wrapf("%s has no delegation", delegatorAddr.String())
+	}
+
+	msgs := make([]sdk.Msg, len(validators))
+	for i, validator := range validators {
+		msgs[i] = &MsgWithdrawDelegatorReward{
+			DelegatorAddress: delegatorAddr.String(),
+			ValidatorAddress: validator,
+		}
+	}
+	return dc.BuildAndSend(msgs, baseTx)
+}
+
+func (dc distributionClient) WithdrawValidatorCommission(baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	operatorAddr, err := dc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msg := &MsgWithdrawValidatorCommission{
+		ValidatorAddress: sdk.ValAddress(operatorAddr.Bytes()).String(),
+	}
+	return dc.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+func (dc distributionClient) SetWithdrawAddr(withdrawAddr string, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	delegatorAddr, err := dc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msg := &MsgSetWithdrawAddress{
+		DelegatorAddress: delegatorAddr.String(),
+		WithdrawAddress:  withdrawAddr,
+	}
+	return dc.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+func (dc distributionClient) FundCommunityPool(amount sdk.DecCoins, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	depositor, err := dc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	coins, err := dc.ToMinCoin(amount...)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msg := &MsgFundCommunityPool{
+		Amount:    coins,
+		Depositor: depositor.String(),
+	}
+	return dc.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+func (dc distributionClient) QueryParams() (QueryParamsResp, sdk.Error) {
+	res, err := dc.QueryParamsResponse(context.Background())
+	if err != nil {
+		return QueryParamsResp{}, sdk.Wrap(err)
+	}
+	return res.Convert().(QueryParamsResp), nil
+}
+
+// QueryParamsResponse returns the grpc response of the params at the height of the context
+func (dc distributionClient) QueryParamsResponse(ctx context.Context) (sdk.Response, error) {
+	conn, err := dc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewQueryClient(conn).Params(ctx, &QueryParamsRequest{})
+}
+
+// QueryValidatorOutstandingRewards returns the rewards of the validator and its delegators not withdrawn yet
+func (dc distributionClient) QueryValidatorOutstandingRewards(validatorAddr string) (sdk.DecCoins, sdk.Error) {
+	conn, err := dc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).ValidatorOutstandingRewards(
+		context.Background(),
+		&QueryValidatorOutstandingRewardsRequest{
+			ValidatorAddress: validatorAddr,
+		},
+	)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+	return res.Rewards.Rewards, nil
+}
+
+func (dc distributionClient) QueryValidatorCommission(validatorAddr string) (sdk.DecCoins, sdk.Error) {
+	conn, err := dc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).ValidatorCommission(
+		context.Background(),
+		&QueryValidatorCommissionRequest{
+			ValidatorAddress: validatorAddr,
+		},
+	)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+	return res.Commission.Commission, nil
+}
+
+func (dc distributionClient) QueryValidatorSlashes(request QueryValidatorSlashesReq) (QueryValidatorSlashesResp, sdk.Error) {
+	endingHeight := request.EndingHeight
+	if endingHeight == 0 {
+		height, err := dc.latestHeight()
+		if err != nil {
+			return QueryValidatorSlashesResp{}, sdk.Wrap(err)
+		}
+		endingHeight = height
+	}
+
+	conn, err := dc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return QueryValidatorSlashesResp{}, sdk.Wrap(err)
+	}
+
+	offset, limit := utils.ParsePage(request.Page, request.Size)
+	res, err := NewQueryClient(conn).ValidatorSlashes(
+		context.Background(),
+		&QueryValidatorSlashesRequest{
+			ValidatorAddress: request.ValidatorAddr,
+			StartingHeight:   request.StartingHeight,
+			EndingHeight:     endingHeight,
+			Pagination: &query.PageRequest{
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: true,
+			},
+		},
+	)
+	if err != nil {
+		return QueryValidatorSlashesResp{}, sdk.Wrap(err)
+	}
+	return res.Convert().(QueryValidatorSlashesResp), nil
+}
+
+func (dc distributionClient) QueryDelegationRewards(delegatorAddr, validatorAddr string) (sdk.DecCoins, sdk.Error) {
+	conn, err := dc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).DelegationRewards(
+		context.Background(),
+		&QueryDelegationRewardsRequest{
+			DelegatorAddress: delegatorAddr,
+			ValidatorAddress: validatorAddr,
+		},
+	)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+	return res.Rewards, nil
+}
+
+func (dc distributionClient) QueryDelegationTotalRewards(delegatorAddr string) (QueryDelegationTotalRewardsResp, sdk.Error) {
+	conn, err := dc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return QueryDelegationTotalRewardsResp{}, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).DelegationTotalRewards(
+		context.Background(),
+		&QueryDelegationTotalRewardsRequest{
+			DelegatorAddress: delegatorAddr,
+		},
+	)
+	if err != nil {
+		return QueryDelegationTotalRewardsResp{}, sdk.Wrap(err)
+	}
+	return res.Convert().(QueryDelegationTotalRewardsResp), nil
+}
+
+// QueryDelegatorValidators returns the addresses of the validators the delegator delegates to
+func (dc distributionClient) QueryDelegatorValidators(delegatorAddr string) ([]string, sdk.Error) {
+	conn, err := dc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).DelegatorValidators(
+		context.Background(),
+		&QueryDelegatorValidatorsRequest{
+			DelegatorAddress: delegatorAddr,
+		},
+	)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+	return res.Validators, nil
+}
+
+func (dc distributionClient) QueryWithdrawAddr(delegatorAddr string) (string, sdk.Error) {
+	conn, err := dc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return "", sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).DelegatorWithdrawAddress(
+		context.Background(),
+		&QueryDelegatorWithdrawAddressRequest{
+			DelegatorAddress: delegatorAddr,
+		},
+	)
+	if err != nil {
+		return "", sdk.Wrap(err)
+	}
+	return res.WithdrawAddress, nil
+}
+
+func (dc distributionClient) QueryCommunityPool() (sdk.DecCoins, sdk.Error) {
+	conn, err := dc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).CommunityPool(
+		context.Background(),
+		&QueryCommunityPoolRequest{},
+	)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+	return res.Pool, nil
+}
+
+func (dc distributionClient) latestHeight() (uint64, error) {
+	status, err := dc.Status(context.Background())
+	if err != nil {
+		return 0, err
+	}
+	return uint64(status.SyncInfo.LatestBlockHeight), nil
+}
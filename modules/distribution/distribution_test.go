@@ -0,0 +1,167 @@
+package distribution_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/irisnet/irishub-sdk-go"
+	"github.com/irisnet/irishub-sdk-go/crypto"
+	"github.com/irisnet/irishub-sdk-go/modules/distribution"
+	"github.com/irisnet/irishub-sdk-go/testutil/simchain"
+	"github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/store"
+)
+
+const (
+	name     = "alice"
+	password = "12345678"
+	mnemonic = "nerve leader thank marriage spice task van start piece crowd run hospital control outside cousin romance left choice poet wagon rude climb leisure spring"
+)
+
+var (
+	validator    = types.ValAddress(tmcrypto.AddressHash([]byte("validator"))).String()
+	withdrawAddr = types.AccAddress(tmcrypto.AddressHash([]byte("withdraw"))).String()
+)
+
+func TestDistribution(t *testing.T) {
+	km, err := crypto.NewMnemonicKeyManager(mnemonic, "secp256k1")
+	require.NoError(t, err)
+	delegator := types.AccAddress(km.ExportPubKey().Address()).String()
+	operator := types.ValAddress(km.ExportPubKey().Address()).String()
+
+	chain, client := newClient(t,
+		simchain.BalanceOption(delegator, types.NewInt64Coin("uiris", 100000000)),
+		simchain.DelegationRewardOption(delegator, validator, types.NewDecCoinFromDec("uiris", types.MustNewDecFromStr("1500.5"))),
+		simchain.DelegationRewardOption(delegator, operator, types.NewInt64DecCoin("uiris", 300)),
+		simchain.CommissionOption(operator, types.NewDecCoinFromDec("uiris", types.MustNewDecFromStr("200.25"))),
+		simchain.ValidatorSlashOption(validator, 10, types.NewDecWithPrec(1, 2)),
+		simchain.ValidatorSlashOption(validator, 20, types.NewDecWithPrec(5, 2)),
+	)
+
+	address, err := client.Key.Recover(name, password, mnemonic)
+	require.NoError(t, err)
+	require.Equal(t, delegator, address)
+
+	params, err := client.Distribution.QueryParams()
+	require.NoError(t, err)
+	require.Equal(t, types.NewDecWithPrec(2, 2), params.CommunityTax)
+	require.True(t, params.WithdrawAddrEnabled)
+
+	validators, err := client.Distribution.QueryDelegatorValidators(delegator)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{validator, operator}, validators)
+
+	rewards, err := client.Distribution.QueryDelegationRewards(delegator, validator)
+	require.NoError(t, err)
+	require.Equal(t, "1500.500000000000000000uiris", rewards.String())
+
+	_, err = client.Distribution.QueryDelegationRewards(delegator, types.ValAddress(tmcrypto.AddressHash([]byte("unknown"))).String())
+	require.Error(t, err)
+
+	totalRewards, err := client.Distribution.QueryDelegationTotalRewards(delegator)
+	require.NoError(t, err)
+	require.Len(t, totalRewards.Rewards, 2)
+	require.Equal(t, "1800.500000000000000000uiris", totalRewards.Total.String())
+
+	// the outstanding rewards of the validator include its commission
+	outstanding, err := client.Distribution.QueryValidatorOutstandingRewards(operator)
+	require.NoError(t, err)
+	require.Equal(t, "500.250000000000000000uiris", outstanding.String())
+
+	commission, err := client.Distribution.QueryValidatorCommission(operator)
+	require.NoError(t, err)
+	require.Equal(t, "200.250000000000000000uiris", commission.String())
+
+	// the slashes are filtered by height and paginated, the ending height defaults to the latest height
+	slashes, err := client.Distribution.QueryValidatorSlashes(distribution.QueryValidatorSlashesReq{
+		ValidatorAddr: validator,
+		EndingHeight:  30,
+		Page:          1,
+		Size:          1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), slashes.Total)
+	require.Len(t, slashes.Slashes, 1)
+	require.Equal(t, types.NewDecWithPrec(1, 2), slashes.Slashes[0].Fraction)
+
+	slashes, err = client.Distribution.QueryValidatorSlashes(distribution.QueryValidatorSlashesReq{
+		ValidatorAddr:  validator,
+		StartingHeight: 15,
+		EndingHeight:   30,
+	})
+	require.NoError(t, err)
+	require.Len(t, slashes.Slashes, 1)
+	require.Equal(t, types.NewDecWithPrec(5, 2), slashes.Slashes[0].Fraction)
+
+	slashes, err = client.Distribution.QueryValidatorSlashes(distribution.QueryValidatorSlashesReq{ValidatorAddr: validator})
+	require.NoError(t, err)
+	require.Empty(t, slashes.Slashes)
+
+	withdrawTo, err := client.Distribution.QueryWithdrawAddr(delegator)
+	require.NoError(t, err)
+	require.Equal(t, delegator, withdrawTo)
+
+	baseTx := types.BaseTx{From: name, Password: password}
+	_, err = client.Distribution.SetWithdrawAddr(withdrawAddr, baseTx)
+	require.NoError(t, err)
+	withdrawTo, err = client.Distribution.QueryWithdrawAddr(delegator)
+	require.NoError(t, err)
+	require.Equal(t, withdrawAddr, withdrawTo)
+
+	// the integral part of the rewards is paid to the withdraw address and the change goes to the community pool
+	res, err := client.Distribution.WithdrawRewards(validator, baseTx)
+	require.NoError(t, err)
+	amount, e := res.Events.GetValue("withdraw_rewards", "amount")
+	require.NoError(t, e)
+	require.Equal(t, "1500uiris", amount)
+	require.Equal(t, "1500uiris", chain.Balances(withdrawAddr).String())
+
+	rewards, err = client.Distribution.QueryDelegationRewards(delegator, validator)
+	require.NoError(t, err)
+	require.True(t, rewards.IsZero())
+
+	pool, err := client.Distribution.QueryCommunityPool()
+	require.NoError(t, err)
+	require.Equal(t, "0.500000000000000000uiris", pool.String())
+
+	_, err = client.Distribution.WithdrawRewards(types.ValAddress(tmcrypto.AddressHash([]byte("unknown"))).String(), baseTx)
+	require.Error(t, err)
+
+	_, err = client.Distribution.WithdrawAllRewards(baseTx)
+	require.NoError(t, err)
+	require.Equal(t, "1800uiris", chain.Balances(withdrawAddr).String())
+
+	// the change of the commission stays with the validator
+	_, err = client.Distribution.WithdrawValidatorCommission(baseTx)
+	require.NoError(t, err)
+	require.Equal(t, "2000uiris", chain.Balances(withdrawAddr).String())
+
+	commission, err = client.Distribution.QueryValidatorCommission(operator)
+	require.NoError(t, err)
+	require.Equal(t, "0.250000000000000000uiris", commission.String())
+
+	fund, err := types.ParseDecCoins("1iris")
+	require.NoError(t, err)
+	_, err = client.Distribution.FundCommunityPool(fund, baseTx)
+	require.NoError(t, err)
+
+	pool, err = client.Distribution.QueryCommunityPool()
+	require.NoError(t, err)
+	require.Equal(t, "1000000.500000000000000000uiris", pool.String())
+}
+
+// newClient returns a new chain of the options and a client of the chain committing the transactions,
+// the chain is closed when the test finishes
+func newClient(t *testing.T, options ...simchain.Option) (*simchain.Chain, sdk.IRISHUBClient) {
+	t.Helper()
+
+	chain, err := simchain.New(options...)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = chain.Close() })
+
+	cfg, err := chain.ClientConfig(types.KeyDAOOption(store.NewMemory(nil)), types.ModeOption(types.Commit))
+	require.NoError(t, err)
+	return chain, sdk.NewIRISHUBClient(cfg)
+}
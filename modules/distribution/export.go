@@ -0,0 +1,70 @@
+package distribution
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// expose Distribution module api for user
+type Client interface {
+	sdk.Module
+
+	// WithdrawRewards withdraws the rewards of the delegation of the sender to the validator
+	WithdrawRewards(validatorAddr string, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+	// WithdrawAllRewards withdraws the rewards of all delegations of the sender in a transaction
+	WithdrawAllRewards(baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+	// WithdrawValidatorCommission withdraws the commission of the validator operated by the sender
+	WithdrawValidatorCommission(baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+	SetWithdrawAddr(withdrawAddr string, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+	FundCommunityPool(amount sdk.DecCoins, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+
+	QueryParams() (QueryParamsResp, sdk.Error)
+	QueryValidatorOutstandingRewards(validatorAddr string) (sdk.DecCoins, sdk.Error)
+	QueryValidatorCommission(validatorAddr string) (sdk.DecCoins, sdk.Error)
+	QueryValidatorSlashes(request QueryValidatorSlashesReq) (QueryValidatorSlashesResp, sdk.Error)
+	QueryDelegationRewards(delegatorAddr, validatorAddr string) (sdk.DecCoins, sdk.Error)
+	QueryDelegationTotalRewards(delegatorAddr string) (QueryDelegationTotalRewardsResp, sdk.Error)
+	QueryDelegatorValidators(delegatorAddr string) ([]string, sdk.Error)
+	QueryWithdrawAddr(delegatorAddr string) (string, sdk.Error)
+	QueryCommunityPool() (sdk.DecCoins, sdk.Error)
+}
+
+type QueryParamsResp struct {
+	CommunityTax        sdk.Dec `json:"community_tax"`
+	BaseProposerReward  sdk.Dec `json:"base_proposer_reward"`
+	BonusProposerReward sdk.Dec `json:"bonus_proposer_reward"`
+	WithdrawAddrEnabled bool    `json:"withdraw_addr_enabled"`
+}
+
+type (
+	// QueryValidatorSlashesReq queries the slashes of the validator between the heights,
+	// an EndingHeight of 0 means the latest height
+	QueryValidatorSlashesReq struct {
+		ValidatorAddr  string `json:"validator_addr"`
+		StartingHeight uint64 `json:"starting_height"`
+		EndingHeight   uint64 `json:"ending_height"`
+		Page           uint64 `json:"page"`
+		Size           uint64 `json:"size"`
+	}
+
+	validatorSlashEvent struct {
+		ValidatorPeriod uint64  `json:"validator_period"`
+		Fraction        sdk.Dec `json:"fraction"`
+	}
+
+	QueryValidatorSlashesResp struct {
+		Slashes []validatorSlashEvent `json:"slashes"`
+		Total   uint64                `json:"total"`
+	}
+)
+
+type (
+	delegationDelegatorReward struct {
+		ValidatorAddress string       `json:"validator_address"`
+		Reward           sdk.DecCoins `json:"reward"`
+	}
+
+	QueryDelegationTotalRewardsResp struct {
+		Rewards []delegationDelegatorReward `json:"rewards"`
+		Total   sdk.DecCoins                `json:"total"`
+	}
+)
@@ -0,0 +1,23 @@
+package distribution
+
+import (
+	yaml "gopkg.in/yaml.v2"
+)
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// String implements the Stringer interface for a ValidatorSlashEvents object.
+func (vs ValidatorSlashEvents) String() string {
+	out, _ := yaml.Marshal(vs)
+	return string(out)
+}
+
+// String implements the Stringer interface for a CommunityPoolSpendProposal object.
+func (csp CommunityPoolSpendProposal) String() string {
+	out, _ := yaml.Marshal(csp)
+	return string(out)
+}
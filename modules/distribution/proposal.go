@@ -0,0 +1,49 @@
+package distribution
+
+import (
+	"github.com/irisnet/irishub-sdk-go/modules/gov"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	// ProposalTypeCommunityPoolSpend defines the type for a CommunityPoolSpendProposal
+	ProposalTypeCommunityPoolSpend = "CommunityPoolSpend"
+)
+
+// Assert CommunityPoolSpendProposal implements gov.Content at compile-time
+var _ gov.Content = &CommunityPoolSpendProposal{}
+
+func init() {
+	gov.RegisterProposalType(ProposalTypeCommunityPoolSpend)
+}
+
+// NewCommunityPoolSpendProposal creates a new community pool spend proposal.
+func NewCommunityPoolSpendProposal(title, description string, recipient sdk.AccAddress, amount sdk.Coins) *CommunityPoolSpendProposal {
+	return &CommunityPoolSpendProposal{title, description, recipient.String(), amount}
+}
+
+// GetTitle returns the title of a community pool spend proposal.
+func (csp *CommunityPoolSpendProposal) GetTitle() string { return csp.Title }
+
+// GetDescription returns the description of a community pool spend proposal.
+func (csp *CommunityPoolSpendProposal) GetDescription() string { return csp.Description }
+
+// ProposalRoute returns the routing key of a community pool spend proposal.
+func (csp *CommunityPoolSpendProposal) ProposalRoute() string { return ModuleName }
+
+// ProposalType returns the type of a community pool spend proposal.
+func (csp *CommunityPoolSpendProposal) ProposalType() string { return ProposalTypeCommunityPoolSpend }
+
+// ValidateBasic runs basic stateless validity checks
+func (csp *CommunityPoolSpendProposal) ValidateBasic() error {
+	if err := gov.ValidateAbstract(csp); err != nil {
+		return err
+	}
+	if !csp.Amount.IsValid() || csp.Amount.Empty() {
+		return sdk.Wrapf("invalid proposal amount: %s", csp.Amount)
+	}
+	if _, err := sdk.AccAddressFromBech32(csp.Recipient); err != nil {
+		return sdk.Wrapf("invalid proposal recipient: %s", csp.Recipient)
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package distribution
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	ModuleName = "distribution"
+
+	TypeMsgSetWithdrawAddress          = "set_withdraw_address"
+	TypeMsgWithdrawDelegatorReward     = "withdraw_delegator_reward"
+	TypeMsgWithdrawValidatorCommission = "withdraw_validator_commission"
+	TypeMsgFundCommunityPool           = "fund_community_pool"
+)
+
+var (
+	_ sdk.Msg = &MsgSetWithdrawAddress{}
+	_ sdk.Msg = &MsgWithdrawDelegatorReward{}
+	_ sdk.Msg = &MsgWithdrawValidatorCommission{}
+	_ sdk.Msg = &MsgFundCommunityPool{}
+)
+
+func (msg MsgSetWithdrawAddress) Route() string { return ModuleName }
+
+func (msg MsgSetWithdrawAddress) Type() string { return TypeMsgSetWithdrawAddress }
+
+func (msg MsgSetWithdrawAddress) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delAddr}
+}
+
+func (msg MsgSetWithdrawAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgSetWithdrawAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdk.Wrapf("invalid delegatorAddress")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.WithdrawAddress); err != nil {
+		return sdk.Wrapf("invalid withdrawAddress")
+	}
+
+	return nil
+}
+
+func (msg MsgWithdrawDelegatorReward) Route() string { return ModuleName }
+
+func (msg MsgWithdrawDelegatorReward) Type() string { return TypeMsgWithdrawDelegatorReward }
+
+func (msg MsgWithdrawDelegatorReward) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delAddr}
+}
+
+func (msg MsgWithdrawDelegatorReward) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgWithdrawDelegatorReward) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdk.Wrapf("invalid delegatorAddress")
+	}
+
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdk.Wrapf("invalid validatorAddress")
+	}
+
+	return nil
+}
+
+func (msg MsgWithdrawValidatorCommission) Route() string { return ModuleName }
+
+func (msg MsgWithdrawValidatorCommission) Type() string { return TypeMsgWithdrawValidatorCommission }
+
+func (msg MsgWithdrawValidatorCommission) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{valAddr.Bytes()}
+}
+
+func (msg MsgWithdrawValidatorCommission) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgWithdrawValidatorCommission) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdk.Wrapf("invalid validatorAddress")
+	}
+
+	return nil
+}
+
+func (msg MsgFundCommunityPool) Route() string { return ModuleName }
+
+func (msg MsgFundCommunityPool) Type() string { return TypeMsgFundCommunityPool }
+
+func (msg MsgFundCommunityPool) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{depositor}
+}
+
+func (msg MsgFundCommunityPool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgFundCommunityPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return sdk.Wrapf("invalid depositor")
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsAllPositive() {
+		return sdk.Wrapf("amount isn't positive or valid")
+	}
+
+	return nil
+}
+
+func (q QueryParamsResponse) Convert() interface{} {
+	return QueryParamsResp{
+		CommunityTax:        q.Params.CommunityTax,
+		BaseProposerReward:  q.Params.BaseProposerReward,
+		BonusProposerReward: q.Params.BonusProposerReward,
+		WithdrawAddrEnabled: q.Params.WithdrawAddrEnabled,
+	}
+}
+
+func (q QueryValidatorSlashesResponse) Convert() interface{} {
+	var slashes []validatorSlashEvent
+	for _, s := range q.Slashes {
+		slashes = append(slashes, validatorSlashEvent{
+			ValidatorPeriod: s.ValidatorPeriod,
+			Fraction:        s.Fraction,
+		})
+	}
+
+	var total uint64
+	if q.Pagination != nil {
+		total = q.Pagination.Total
+	}
+	return QueryValidatorSlashesResp{
+		Slashes: slashes,
+		Total:   total,
+	}
+}
+
+func (q QueryDelegationTotalRewardsResponse) Convert() interface{} {
+	var rewards []delegationDelegatorReward
+	for _, r := range q.Rewards {
+		rewards = append(rewards, delegationDelegatorReward{
+			ValidatorAddress: r.ValidatorAddress,
+			Reward:           r.Reward,
+		})
+	}
+
+	return QueryDelegationTotalRewardsResp{
+		Rewards: rewards,
+		Total:   q.Total,
+	}
+}
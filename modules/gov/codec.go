@@ -22,4 +22,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDeposit{},
 		&MsgVote{},
 	)
+
+	registry.RegisterInterface(
+		"cosmos.gov.v1beta1.Content",
+		(*Content)(nil),
+		&TextProposal{},
+	)
 }
@@ -27,6 +27,9 @@ type SubmitProposalRequest struct {
 	Description    string       `json:"description"`
 	Type           string       `json:"type"`
 	InitialDeposit sdk.DecCoins `json:"initial_deposit"`
+	// Content is submitted instead of the content of the Title, Description and Type if set,
+	// e.g. params.NewParameterChangeProposal or upgrade.NewSoftwareUpgradeProposal
+	Content Content `json:"content,omitempty"`
 }
 
 type DepositRequest struct {
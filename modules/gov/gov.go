@@ -40,10 +40,17 @@ func (gc govClient) SubmitProposal(request SubmitProposalRequest, baseTx sdk.Bas
 		return 0, sdk.ResultTx{}, sdk.Wrap(err)
 	}
 
-	content := ContentFromProposalType(request.Title, request.Description, request.Type)
+	content := request.Content
+	if content == nil {
+		content = ContentFromProposalType(request.Title, request.Description, request.Type)
+	}
+	if content == nil {
+		return 0, sdk.ResultTx{}, sdk.Wrapf("unknown proposal type %s", request.Type)
+	}
+
 	msg, e := NewMsgSubmitProposal(content, deposit, proposer)
 	if e != nil {
-		return 0, sdk.ResultTx{}, sdk.Wrap(err)
+		return 0, sdk.ResultTx{}, sdk.Wrap(e)
 	}
 
 	result, err := gc.BuildAndSend([]sdk.Msg{msg}, baseTx)
@@ -113,6 +120,7 @@ func (gc govClient) QueryProposal(proposalId uint64) (QueryProposalResp, sdk.Err
 	if err != nil {
 		return QueryProposalResp{}, sdk.Wrap(err)
 	}
+	gc.unpackContent(res.Proposal)
 	return res.Proposal.Convert().(QueryProposalResp), nil
 }
 
@@ -138,9 +146,19 @@ func (gc govClient) QueryProposals(proposalStatus string) ([]QueryProposalResp,
 	if err != nil {
 		return nil, sdk.Wrap(err)
 	}
+	for _, proposal := range res.Proposals {
+		gc.unpackContent(proposal)
+	}
 	return Proposals(res.Proposals).Convert().([]QueryProposalResp), nil
 }
 
+// unpackContent decodes the content of the proposal, the content is left empty if its type is not registered
+func (gc govClient) unpackContent(proposal Proposal) {
+	if err := proposal.UnpackInterfaces(gc.Marshaler); err != nil {
+		gc.Logger().Debug("failed to decode the proposal content", "proposal_id", proposal.ProposalId, "errMsg", err.Error())
+	}
+}
+
 // about QueryVoteResp.Option see VoteOption_name
 func (gc govClient) QueryVote(proposalId uint64, voter string) (QueryVoteResp, sdk.Error) {
 	conn, err := gc.GenConn()
@@ -186,6 +204,14 @@ func (gc govClient) QueryVotes(proposalId uint64) ([]QueryVoteResp, sdk.Error) {
 
 // QueryParams params_type("voting", "tallying", "deposit"), if don't pass will return all params_typ res
 func (gc govClient) QueryParams(paramsType string) (QueryParamsResp, sdk.Error) {
+	if len(paramsType) == 0 {
+		res, err := gc.QueryParamsResponse(context.Background())
+		if err != nil {
+			return QueryParamsResp{}, sdk.Wrap(err)
+		}
+		return res.Convert().(QueryParamsResp), nil
+	}
+
 	conn, err := gc.GenConn()
 	defer func() { _ = conn.Close() }()
 	if err != nil {
@@ -204,6 +230,36 @@ func (gc govClient) QueryParams(paramsType string) (QueryParamsResp, sdk.Error)
 	return res.Convert().(QueryParamsResp), nil
 }
 
+// QueryParamsResponse returns the voting, deposit and tally params at the height of the context,
+// the chain returns one type of params for a query
+func (gc govClient) QueryParamsResponse(ctx context.Context) (sdk.Response, error) {
+	conn, err := gc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	queryClient := NewQueryClient(conn)
+	voting, err := queryClient.Params(ctx, &QueryParamsRequest{ParamsType: ParamsTypeVoting})
+	if err != nil {
+		return nil, err
+	}
+	deposit, err := queryClient.Params(ctx, &QueryParamsRequest{ParamsType: ParamsTypeDeposit})
+	if err != nil {
+		return nil, err
+	}
+	tally, err := queryClient.Params(ctx, &QueryParamsRequest{ParamsType: ParamsTypeTallying})
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryParamsResponse{
+		VotingParams:  voting.VotingParams,
+		DepositParams: deposit.DepositParams,
+		TallyParams:   tally.TallyParams,
+	}, nil
+}
+
 func (gc govClient) QueryDeposit(proposalId uint64, depositor string) (QueryDepositResp, sdk.Error) {
 	conn, err := gc.GenConn()
 	defer func() { _ = conn.Close() }()
@@ -206,8 +206,18 @@ func (tp *TextProposal) ProposalRoute() string { return ModuleName }
 func (tp *TextProposal) ProposalType() string { return ProposalTypeText }
 
 // ValidateBasic validates the content's title and description of the proposal
-func (tp *TextProposal) ValidateBasic() error {
-	title := tp.GetTitle()
+func (tp *TextProposal) ValidateBasic() error { return ValidateAbstract(tp) }
+
+// String implements Stringer interface
+func (tp TextProposal) String() string {
+	out, _ := yaml.Marshal(tp)
+	return string(out)
+}
+
+// ValidateAbstract validates the title and description of the proposal content,
+// the contents of the other modules validate their own fields besides them
+func ValidateAbstract(c Content) error {
+	title := c.GetTitle()
 	if len(strings.TrimSpace(title)) == 0 {
 		return sdk.Wrapf("proposal title cannot be blank")
 	}
@@ -215,7 +225,7 @@ func (tp *TextProposal) ValidateBasic() error {
 		return sdk.Wrapf("proposal title is longer than max length of %d", MaxTitleLength)
 	}
 
-	description := tp.GetDescription()
+	description := c.GetDescription()
 	if len(description) == 0 {
 		return sdk.Wrapf("proposal description cannot be blank")
 	}
@@ -225,12 +235,6 @@ func (tp *TextProposal) ValidateBasic() error {
 	return nil
 }
 
-// String implements Stringer interface
-func (tp TextProposal) String() string {
-	out, _ := yaml.Marshal(tp)
-	return string(out)
-}
-
 var validProposalTypes = map[string]struct{}{
 	ProposalTypeText: {},
 }
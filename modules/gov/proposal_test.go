@@ -0,0 +1,91 @@
+package gov_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	codectypes "github.com/irisnet/irishub-sdk-go/codec/types"
+	"github.com/irisnet/irishub-sdk-go/modules/distribution"
+	"github.com/irisnet/irishub-sdk-go/modules/gov"
+	"github.com/irisnet/irishub-sdk-go/modules/params"
+	"github.com/irisnet/irishub-sdk-go/modules/upgrade"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const recipient = "iaa1qzds87rxyrv4ak9gr2mx9ptjhsqs5thcha0e5n"
+
+func TestProposalContents(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	gov.RegisterInterfaces(registry)
+	distribution.RegisterInterfaces(registry)
+	params.RegisterInterfaces(registry)
+	upgrade.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+	require.NoError(t, err)
+
+	contents := []gov.Content{
+		gov.NewTextProposal("text", "description"),
+		params.NewParameterChangeProposal("params", "description", []params.ParamChange{
+			params.NewParamChange("staking", "MaxValidators", "105"),
+		}),
+		upgrade.NewSoftwareUpgradeProposal("upgrade", "description", upgrade.Plan{Name: "v2", Height: 1000}),
+		upgrade.NewCancelSoftwareUpgradeProposal("cancel", "description"),
+		distribution.NewCommunityPoolSpendProposal("spend", "description", recipientAddr,
+			sdk.NewCoins(sdk.NewInt64Coin("uiris", 1000))),
+	}
+
+	for i, content := range contents {
+		require.NoError(t, content.ValidateBasic(), content.ProposalType())
+		require.True(t, gov.IsValidProposalType(content.ProposalType()))
+
+		proposal, err := gov.NewProposal(content, uint64(i+1), time.Now(), time.Now())
+		require.NoError(t, err)
+
+		bz, err := cdc.MarshalBinaryBare(&proposal)
+		require.NoError(t, err)
+
+		var decoded gov.Proposal
+		require.NoError(t, cdc.UnmarshalBinaryBare(bz, &decoded))
+		require.NoError(t, decoded.UnpackInterfaces(cdc))
+
+		resp := decoded.Convert().(gov.QueryProposalResp)
+		require.Equal(t, content, resp.Content)
+	}
+}
+
+func TestProposalValidation(t *testing.T) {
+	invalid := []gov.Content{
+		gov.NewTextProposal("", "description"),
+		params.NewParameterChangeProposal("params", "description", nil),
+		params.NewParameterChangeProposal("params", "description", []params.ParamChange{
+			params.NewParamChange("staking", "", "105"),
+		}),
+		upgrade.NewSoftwareUpgradeProposal("upgrade", "description", upgrade.Plan{Name: "v2"}),
+		upgrade.NewSoftwareUpgradeProposal("upgrade", "description", upgrade.Plan{
+			Name:   "v2",
+			Height: 1000,
+			Time:   time.Now(),
+		}),
+		upgrade.NewCancelSoftwareUpgradeProposal("cancel", ""),
+		&distribution.CommunityPoolSpendProposal{
+			Title:       "spend",
+			Description: "description",
+			Recipient:   "invalid",
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("uiris", 1000)),
+		},
+		&distribution.CommunityPoolSpendProposal{
+			Title:       "spend",
+			Description: "description",
+			Recipient:   recipient,
+		},
+	}
+
+	for _, content := range invalid {
+		require.Error(t, content.ValidateBasic(), content.String())
+	}
+}
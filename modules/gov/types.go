@@ -11,6 +11,10 @@ import (
 const (
 	ModuleName = "gov"
 
+	ParamsTypeVoting   = "voting"
+	ParamsTypeDeposit  = "deposit"
+	ParamsTypeTallying = "tallying"
+
 	AttributeKeyProposalId = "proposal_id"
 )
 
@@ -202,6 +206,7 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 func (q Proposal) Convert() interface{} {
 	return QueryProposalResp{
 		ProposalId: q.ProposalId,
+		Content:    q.GetContent(),
 		Status:     ProposalStatus_name[int32(q.Status)],
 		FinalTallyResult: QueryTallyResultResp{
 			Yes:        q.FinalTallyResult.Yes,
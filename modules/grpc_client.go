@@ -2,6 +2,8 @@ package modules
 
 import (
 	"google.golang.org/grpc"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
 )
 
 type grpcClient struct {
@@ -12,6 +14,6 @@ func NewGRPCClient(url string) grpcClient {
 	return grpcClient{url: url}
 }
 
-func (g grpcClient) GenConn() (*grpc.ClientConn, error) {
+func (g grpcClient) GenConn() (sdk.QueryConn, error) {
 	return grpc.Dial(g.url, grpc.WithInsecure())
 }
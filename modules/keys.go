@@ -2,11 +2,14 @@ package modules
 
 import (
 	"fmt"
+	"time"
 
 	tmcrypto "github.com/tendermint/tendermint/crypto"
 
 	"github.com/irisnet/irishub-sdk-go/crypto"
 	cryptoamino "github.com/irisnet/irishub-sdk-go/crypto/codec"
+	"github.com/irisnet/irishub-sdk-go/crypto/hd"
+	kmultisig "github.com/irisnet/irishub-sdk-go/crypto/keys/multisig"
 	"github.com/irisnet/irishub-sdk-go/types"
 	"github.com/irisnet/irishub-sdk-go/types/store"
 )
@@ -21,6 +24,9 @@ func (k keyManager) Sign(name, password string, data []byte) ([]byte, tmcrypto.P
 	if err != nil {
 		return nil, nil, fmt.Errorf("name %s not exist", name)
 	}
+	if info.Type != store.TypeLocal {
+		return nil, nil, fmt.Errorf("the %s key %s can not sign", info.Type, name)
+	}
 
 	km, err := crypto.NewPrivateKeyManager([]byte(info.PrivKeyArmor), string(info.Algo))
 	if err != nil {
@@ -35,12 +41,13 @@ func (k keyManager) Sign(name, password string, data []byte) ([]byte, tmcrypto.P
 	return signByte, km.ExportPubKey(), nil
 }
 
-func (k keyManager) Insert(name, password string) (string, string, error) {
+func (k keyManager) Insert(name, password string, options ...types.KeyOption) (string, string, error) {
 	if k.keyDAO.Has(name) {
 		return "", "", fmt.Errorf("name %s has existed", name)
 	}
 
-	km, err := crypto.NewAlgoKeyManager(k.algo)
+	opts := k.keyOptions(options)
+	km, err := crypto.NewAlgoKeyManagerWithHDPath(opts.Algo, opts.HDPath.String(), opts.BIP39Passphrase)
 	if err != nil {
 		return "", "", err
 	}
@@ -54,7 +61,9 @@ func (k keyManager) Insert(name, password string) (string, string, error) {
 		Name:         name,
 		PubKey:       cryptoamino.MarshalPubkey(pubKey),
 		PrivKeyArmor: string(cryptoamino.MarshalPrivKey(priv)),
-		Algo:         k.algo,
+		Algo:         opts.Algo,
+		HDPath:       opts.HDPath.String(),
+		CreatedAt:    time.Now().UTC(),
 	}
 
 	if err = k.keyDAO.Write(name, password, info); err != nil {
@@ -63,12 +72,13 @@ func (k keyManager) Insert(name, password string) (string, string, error) {
 	return address, mnemonic, nil
 }
 
-func (k keyManager) Recover(name, password, mnemonic string) (string, error) {
+func (k keyManager) Recover(name, password, mnemonic string, options ...types.KeyOption) (string, error) {
 	if k.keyDAO.Has(name) {
 		return "", fmt.Errorf("name %s has existed", name)
 	}
 
-	km, err := crypto.NewMnemonicKeyManager(mnemonic, k.algo)
+	opts := k.keyOptions(options)
+	km, err := crypto.NewMnemonicKeyManagerWithHDPath(mnemonic, opts.Algo, opts.HDPath.String(), opts.BIP39Passphrase)
 	if err != nil {
 		return "", err
 	}
@@ -82,7 +92,9 @@ func (k keyManager) Recover(name, password, mnemonic string) (string, error) {
 		Name:         name,
 		PubKey:       cryptoamino.MarshalPubkey(pubKey),
 		PrivKeyArmor: string(cryptoamino.MarshalPrivKey(priv)),
-		Algo:         k.algo,
+		Algo:         opts.Algo,
+		HDPath:       opts.HDPath.String(),
+		CreatedAt:    time.Now().UTC(),
 	}
 
 	if err = k.keyDAO.Write(name, password, info); err != nil {
@@ -99,7 +111,7 @@ func (k keyManager) Import(name, password, armor string) (string, error) {
 
 	km := crypto.NewKeyManager()
 
-	priv, _, err := km.ImportPrivKey(armor, password)
+	priv, algo, err := km.ImportPrivKey(armor, password)
 	if err != nil {
 		return "", err
 	}
@@ -111,7 +123,8 @@ func (k keyManager) Import(name, password, armor string) (string, error) {
 		Name:         name,
 		PubKey:       cryptoamino.MarshalPubkey(pubKey),
 		PrivKeyArmor: string(cryptoamino.MarshalPrivKey(priv)),
-		Algo:         k.algo,
+		Algo:         algo,
+		CreatedAt:    time.Now().UTC(),
 	}
 
 	err = k.keyDAO.Write(name, password, info)
@@ -121,11 +134,63 @@ func (k keyManager) Import(name, password, armor string) (string, error) {
 	return address, nil
 }
 
+// ImportPubKey stores a watch-only key, it can be the sender of the unsigned transactions.
+// The password only encrypts the key in the KeyDAO encrypting the public keys, e.g. the FileDAO
+func (k keyManager) ImportPubKey(name, password string, pubKey tmcrypto.PubKey) (string, error) {
+	if pubKey == nil {
+		return "", fmt.Errorf("public key is required")
+	}
+	return k.importPublic(name, password, pubKey, store.TypeOffline, pubKey.Type())
+}
+
+// ImportMultisig stores the multisig key of the threshold and the public keys of its members,
+// the address depends on the order of the public keys
+func (k keyManager) ImportMultisig(name, password string, threshold int, pubKeys []tmcrypto.PubKey) (string, error) {
+	if threshold <= 0 || threshold > len(pubKeys) {
+		return "", fmt.Errorf("threshold must be between 1 and the number of public keys(%d)", len(pubKeys))
+	}
+
+	seen := make(map[string]bool, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		if pubKey == nil {
+			return "", fmt.Errorf("public key is required")
+		}
+		if seen[pubKey.Address().String()] {
+			return "", fmt.Errorf("duplicate public key of %s", types.AccAddress(pubKey.Address()).String())
+		}
+		seen[pubKey.Address().String()] = true
+	}
+
+	pubKey := kmultisig.NewLegacyAminoPubKey(threshold, pubKeys)
+	return k.importPublic(name, password, pubKey, store.TypeMulti, string(hd.MultiType))
+}
+
+func (k keyManager) importPublic(name, password string, pubKey tmcrypto.PubKey, keyType store.KeyType, algo string) (string, error) {
+	if k.keyDAO.Has(name) {
+		return "", fmt.Errorf("%s has existed", name)
+	}
+
+	info := store.KeyInfo{
+		Name:      name,
+		PubKey:    cryptoamino.MarshalPubkey(pubKey),
+		Algo:      algo,
+		Type:      keyType,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := k.keyDAO.Write(name, password, info); err != nil {
+		return "", err
+	}
+	return types.AccAddress(pubKey.Address().Bytes()).String(), nil
+}
+
 func (k keyManager) Export(name, password string) (armor string, err error) {
 	info, err := k.keyDAO.Read(name, password)
 	if err != nil {
 		return armor, fmt.Errorf("name %s not exist", name)
 	}
+	if info.Type != store.TypeLocal {
+		return "", fmt.Errorf("the %s key %s has no private key", info.Type, name)
+	}
 
 	km, err := crypto.NewPrivateKeyManager([]byte(info.PrivKeyArmor), info.Algo)
 	if err != nil {
@@ -139,6 +204,19 @@ func (k keyManager) Delete(name, password string) error {
 	return k.keyDAO.Delete(name, password)
 }
 
+func (k keyManager) ChangePassword(name, oldPassword, newPassword string) error {
+	if len(newPassword) == 0 {
+		return fmt.Errorf("new password can not be empty")
+	}
+	if !k.keyDAO.Has(name) {
+		return fmt.Errorf("name %s not exist", name)
+	}
+	if info, err := k.keyDAO.ReadMetadata(name); err == nil && info.Type != store.TypeLocal {
+		return fmt.Errorf("the %s key %s has no password", info.Type, name)
+	}
+	return k.keyDAO.ChangePassword(name, oldPassword, newPassword)
+}
+
 func (k keyManager) Find(name, password string) (tmcrypto.PubKey, types.AccAddress, error) {
 	info, err := k.keyDAO.Read(name, password)
 	if err != nil {
@@ -152,3 +230,30 @@ func (k keyManager) Find(name, password string) (tmcrypto.PubKey, types.AccAddre
 
 	return pubKey, types.AccAddress(pubKey.Address().Bytes()), nil
 }
+
+// Info returns the information of the key without decrypting the private key
+func (k keyManager) Info(name string) (store.KeyInfo, error) {
+	if !k.keyDAO.Has(name) {
+		return store.KeyInfo{}, fmt.Errorf("name %s not exist", name)
+	}
+
+	info, err := k.keyDAO.ReadMetadata(name)
+	if err != nil {
+		return store.KeyInfo{}, err
+	}
+	info.PrivKeyArmor = ""
+	return info, nil
+}
+
+func (k keyManager) List() ([]store.KeyInfo, error) {
+	return k.keyDAO.List()
+}
+
+// keyOptions applies the options of a key on the defaults of the client
+func (k keyManager) keyOptions(options []types.KeyOption) types.KeyOptions {
+	opts := types.DefaultKeyOptions(k.algo)
+	for _, option := range options {
+		option(&opts)
+	}
+	return opts
+}
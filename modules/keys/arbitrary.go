@@ -0,0 +1,115 @@
+package keys
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// msgSignDataType is the amino type of the message signing arbitrary data defined by ADR-036
+const msgSignDataType = "sign/MsgSignData"
+
+// ArbitrarySignature is the signature of arbitrary data signed off-chain
+type ArbitrarySignature struct {
+	// bech32 address of the signer
+	Signer string `json:"signer"`
+	// bech32 public key of the signer
+	PubKey    string `json:"pub_key"`
+	Signature []byte `json:"signature"`
+	// SignDoc is the ADR-036 sign doc which is signed
+	SignDoc []byte `json:"sign_doc"`
+}
+
+type arbitrarySignDoc struct {
+	AccountNumber string         `json:"account_number"`
+	ChainID       string         `json:"chain_id"`
+	Fee           arbitraryFee   `json:"fee"`
+	Memo          string         `json:"memo"`
+	Msgs          []arbitraryMsg `json:"msgs"`
+	Sequence      string         `json:"sequence"`
+}
+
+type arbitraryFee struct {
+	Amount sdk.Coins `json:"amount"`
+	Gas    string    `json:"gas"`
+}
+
+type arbitraryMsg struct {
+	Type  string           `json:"type"`
+	Value arbitraryMsgData `json:"value"`
+}
+
+type arbitraryMsgData struct {
+	Data   []byte `json:"data"`
+	Signer string `json:"signer"`
+}
+
+// ArbitrarySignBytes returns the ADR-036 sign doc of the data signed by the signer, it is the amino JSON
+// of a tx with a single MsgSignData and an empty chain-id, memo, fee, account number and sequence,
+// the same as the sign doc of the `signArbitrary` of Keplr
+func ArbitrarySignBytes(signer string, data []byte) []byte {
+	if data == nil {
+		data = []byte{}
+	}
+	bz, err := json.Marshal(arbitrarySignDoc{
+		AccountNumber: "0",
+		ChainID:       "",
+		Fee:           arbitraryFee{Amount: sdk.Coins{}, Gas: "0"},
+		Memo:          "",
+		Msgs: []arbitraryMsg{{
+			Type:  msgSignDataType,
+			Value: arbitraryMsgData{Data: data, Signer: signer},
+		}},
+		Sequence: "0",
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+func (k keysClient) SignArbitrary(name, password string, data []byte) (ArbitrarySignature, sdk.Error) {
+	_, address, err := k.KeyManager.Find(name, password)
+	if err != nil {
+		return ArbitrarySignature{}, sdk.Wrap(err)
+	}
+
+	signDoc := ArbitrarySignBytes(address.String(), data)
+	signature, pubKey, err := k.KeyManager.Sign(name, password, signDoc)
+	if err != nil {
+		return ArbitrarySignature{}, sdk.Wrap(err)
+	}
+
+	bech32PubKey, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pubKey)
+	if err != nil {
+		return ArbitrarySignature{}, sdk.Wrap(err)
+	}
+
+	return ArbitrarySignature{
+		Signer:    address.String(),
+		PubKey:    bech32PubKey,
+		Signature: signature,
+		SignDoc:   signDoc,
+	}, nil
+}
+
+// VerifyArbitrary verifies the ADR-036 signature of the data signed by the address,
+// the public key must be the one of the address
+func VerifyArbitrary(address string, pubKey crypto.PubKey, data, signature []byte) sdk.Error {
+	signer, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+
+	if pubKey == nil || !bytes.Equal(pubKey.Address(), signer) {
+		return sdk.Wrapf("the public key does not belong to %s", address)
+	}
+
+	if !pubKey.VerifySignature(ArbitrarySignBytes(address, data), signature) {
+		return sdk.Wrapf("invalid signature of %s", address)
+	}
+	return nil
+}
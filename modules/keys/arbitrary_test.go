@@ -0,0 +1,62 @@
+package keys_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+
+	"github.com/irisnet/irishub-sdk-go/crypto"
+	"github.com/irisnet/irishub-sdk-go/modules/keys"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const mnemonic = "nerve leader thank marriage spice task van start piece crowd run hospital control outside cousin romance left choice poet wagon rude climb leisure spring"
+
+// keyManager signs by a single key derived from the mnemonic
+type keyManager struct {
+	sdk.KeyManager
+	km crypto.KeyManager
+}
+
+func (k keyManager) Sign(_, _ string, data []byte) ([]byte, tmcrypto.PubKey, error) {
+	signature, err := k.km.Sign(data)
+	return signature, k.km.ExportPubKey(), err
+}
+
+func (k keyManager) Find(_, _ string) (tmcrypto.PubKey, sdk.AccAddress, error) {
+	pubKey := k.km.ExportPubKey()
+	return pubKey, sdk.AccAddress(pubKey.Address()), nil
+}
+
+func TestArbitrarySignBytes(t *testing.T) {
+	require.Equal(t,
+		`{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"","msgs":[{"type":"sign/MsgSignData","value":{"data":"bG9naW4=","signer":"iaa1y9kd9uy7a4qnjp0z5yjx5jhrkv2ycdkzqc0h8z"}}],"sequence":"0"}`,
+		string(keys.ArbitrarySignBytes("iaa1y9kd9uy7a4qnjp0z5yjx5jhrkv2ycdkzqc0h8z", []byte("login"))),
+	)
+}
+
+func TestSignArbitrary(t *testing.T) {
+	data := []byte("login challenge")
+
+	for _, algo := range []string{"secp256k1", "sm2", "ed25519"} {
+		km, err := crypto.NewMnemonicKeyManager(mnemonic, algo)
+		require.NoError(t, err)
+		client := keys.NewClient(keyManager{km: km})
+
+		sig, e := client.SignArbitrary("alice", "12345678", data)
+		require.NoError(t, e)
+
+		pubKey := km.ExportPubKey()
+		require.Equal(t, sdk.AccAddress(pubKey.Address()).String(), sig.Signer)
+		require.Equal(t, keys.ArbitrarySignBytes(sig.Signer, data), sig.SignDoc)
+
+		bech32PubKey, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pubKey)
+		require.NoError(t, err)
+		require.Equal(t, bech32PubKey, sig.PubKey)
+
+		require.NoError(t, keys.VerifyArbitrary(sig.Signer, pubKey, data, sig.Signature))
+		require.Error(t, keys.VerifyArbitrary(sig.Signer, pubKey, []byte("another challenge"), sig.Signature))
+		require.Error(t, keys.VerifyArbitrary("iaa1qzds87rxyrv4ak9gr2mx9ptjhsqs5thcha0e5n", pubKey, data, sig.Signature))
+	}
+}
@@ -1,14 +1,37 @@
 package keys
 
 import (
+	"time"
+
 	sdk "github.com/irisnet/irishub-sdk-go/types"
 )
 
 type Client interface {
-	Add(name, password string) (address string, mnemonic string, err sdk.Error)
-	Recover(name, password, mnemonic string) (address string, err sdk.Error)
+	Add(name, password string, options ...sdk.KeyOption) (address string, mnemonic string, err sdk.Error)
+	Recover(name, password, mnemonic string, options ...sdk.KeyOption) (address string, err sdk.Error)
+	RecoverFromShares(name, password string, shares []string, options ...sdk.KeyOption) (address string, err sdk.Error)
 	Import(name, password, privKeyArmor string) (address string, err sdk.Error)
+	ImportWatchOnly(name, password, address string, pubKey sdk.TmPubKey) (string, sdk.Error)
+	ImportMultisig(name, password string, threshold int, pubKeys []sdk.TmPubKey) (address string, err sdk.Error)
 	Export(name, password string) (privKeyArmor string, err sdk.Error)
 	Delete(name, password string) sdk.Error
+	ChangePassword(name, oldPassword, newPassword string) sdk.Error
 	Show(name, password string) (string, sdk.Error)
+	ShowInfo(name string) (KeyInfo, sdk.Error)
+	List() ([]KeyInfo, sdk.Error)
+	SignArbitrary(name, password string, data []byte) (ArbitrarySignature, sdk.Error)
+}
+
+// KeyInfo is the public information of a key, it is read without the password
+type KeyInfo struct {
+	Name      string    `json:"name"`
+	Address   string    `json:"address"`
+	PubKey    string    `json:"pubkey"`
+	Algo      string    `json:"algo"`
+	Type      string    `json:"type"`
+	HDPath    string    `json:"hd_path,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	// Threshold and PubKeys(bech32) of the members of a multisig key
+	Threshold uint32   `json:"threshold,omitempty"`
+	PubKeys   []string `json:"pubkeys,omitempty"`
 }
@@ -1,7 +1,13 @@
 package keys
 
 import (
+	"bytes"
+
+	"github.com/irisnet/irishub-sdk-go/crypto"
+	cryptoamino "github.com/irisnet/irishub-sdk-go/crypto/codec"
+	kmultisig "github.com/irisnet/irishub-sdk-go/crypto/keys/multisig"
 	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/store"
 )
 
 type keysClient struct {
@@ -12,21 +18,69 @@ func NewClient(keyManager sdk.KeyManager) Client {
 	return keysClient{keyManager}
 }
 
-func (k keysClient) Add(name, password string) (string, string, sdk.Error) {
-	address, mnemonic, err := k.Insert(name, password)
+func (k keysClient) Add(name, password string, options ...sdk.KeyOption) (string, string, sdk.Error) {
+	address, mnemonic, err := k.Insert(name, password, options...)
 	return address, mnemonic, sdk.Wrap(err)
 }
 
-func (k keysClient) Recover(name, password, mnemonic string) (string, sdk.Error) {
-	address, err := k.KeyManager.Recover(name, password, mnemonic)
+func (k keysClient) Recover(name, password, mnemonic string, options ...sdk.KeyOption) (string, sdk.Error) {
+	address, err := k.KeyManager.Recover(name, password, mnemonic, options...)
 	return address, sdk.Wrap(err)
 }
 
+// RecoverFromShares recovers the key from the shares of its mnemonic or private key split by the crypto package,
+// the private key is decrypted by the password, which must be the one used to export it
+func (k keysClient) RecoverFromShares(name, password string, shares []string, options ...sdk.KeyOption) (string, sdk.Error) {
+	if len(shares) == 0 {
+		return "", sdk.Wrapf("no shares")
+	}
+
+	kind, err := crypto.ShareKind(shares[0])
+	if err != nil {
+		return "", sdk.Wrap(err)
+	}
+
+	switch kind {
+	case crypto.ShareKindMnemonic:
+		mnemonic, err := crypto.CombineMnemonic(shares)
+		if err != nil {
+			return "", sdk.Wrap(err)
+		}
+		return k.Recover(name, password, mnemonic, options...)
+	default:
+		armor, err := crypto.CombinePrivKey(shares)
+		if err != nil {
+			return "", sdk.Wrap(err)
+		}
+		return k.Import(name, password, armor)
+	}
+}
+
 func (k keysClient) Import(name, password, privKeyArmor string) (string, sdk.Error) {
 	address, err := k.KeyManager.Import(name, password, privKeyArmor)
 	return address, sdk.Wrap(err)
 }
 
+// ImportWatchOnly stores the address and public key without the private key, it can be
+// the sender of the unsigned transactions signed later
+func (k keysClient) ImportWatchOnly(name, password, address string, pubKey sdk.TmPubKey) (string, sdk.Error) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return "", err
+	}
+	if pubKey == nil || !bytes.Equal(pubKey.Address(), addr) {
+		return "", sdk.Wrapf("the public key does not belong to %s", address)
+	}
+
+	address, e := k.KeyManager.ImportPubKey(name, password, pubKey)
+	return address, sdk.Wrap(e)
+}
+
+func (k keysClient) ImportMultisig(name, password string, threshold int, pubKeys []sdk.TmPubKey) (string, sdk.Error) {
+	address, err := k.KeyManager.ImportMultisig(name, password, threshold, pubKeys)
+	return address, sdk.Wrap(err)
+}
+
 func (k keysClient) Export(name, password string) (string, sdk.Error) {
 	keystore, err := k.KeyManager.Export(name, password)
 	return keystore, sdk.Wrap(err)
@@ -37,6 +91,11 @@ func (k keysClient) Delete(name, password string) sdk.Error {
 	return sdk.Wrap(err)
 }
 
+func (k keysClient) ChangePassword(name, oldPassword, newPassword string) sdk.Error {
+	err := k.KeyManager.ChangePassword(name, oldPassword, newPassword)
+	return sdk.Wrap(err)
+}
+
 func (k keysClient) Show(name, password string) (string, sdk.Error) {
 	_, address, err := k.KeyManager.Find(name, password)
 	if err != nil {
@@ -44,3 +103,67 @@ func (k keysClient) Show(name, password string) (string, sdk.Error) {
 	}
 	return address.String(), nil
 }
+
+func (k keysClient) ShowInfo(name string) (KeyInfo, sdk.Error) {
+	info, err := k.KeyManager.Info(name)
+	if err != nil {
+		return KeyInfo{}, sdk.Wrap(err)
+	}
+	return newKeyInfo(info)
+}
+
+func (k keysClient) List() ([]KeyInfo, sdk.Error) {
+	infos, err := k.KeyManager.List()
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	keys := make([]KeyInfo, 0, len(infos))
+	for _, info := range infos {
+		key, err := newKeyInfo(info)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func newKeyInfo(info store.KeyInfo) (KeyInfo, sdk.Error) {
+	key := KeyInfo{
+		Name:      info.Name,
+		Algo:      info.Algo,
+		Type:      info.Type.String(),
+		HDPath:    info.HDPath,
+		CreatedAt: info.CreatedAt,
+	}
+	// the public key of a key written by another keyring may need the password
+	if len(info.PubKey) == 0 {
+		return key, nil
+	}
+
+	pubKey, err := cryptoamino.PubKeyFromBytes(info.PubKey)
+	if err != nil {
+		return KeyInfo{}, sdk.WrapWithMessage(err, "invalid public key of %s", info.Name)
+	}
+
+	bech32PubKey, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pubKey)
+	if err != nil {
+		return KeyInfo{}, sdk.Wrap(err)
+	}
+
+	key.Address = sdk.AccAddress(pubKey.Address().Bytes()).String()
+	key.PubKey = bech32PubKey
+
+	if multisigPubKey, ok := pubKey.(*kmultisig.LegacyAminoPubKey); ok {
+		key.Threshold = multisigPubKey.Threshold
+		for _, member := range multisigPubKey.GetPubKeys() {
+			bech32PubKey, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, member)
+			if err != nil {
+				return KeyInfo{}, sdk.Wrap(err)
+			}
+			key.PubKeys = append(key.PubKeys, bech32PubKey)
+		}
+	}
+	return key, nil
+}
@@ -0,0 +1,44 @@
+package keys_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/irisnet/irishub-sdk-go"
+	"github.com/irisnet/irishub-sdk-go/crypto"
+	"github.com/irisnet/irishub-sdk-go/testutil/simchain"
+	"github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/store"
+)
+
+func TestRecoverFromShares(t *testing.T) {
+	chain, err := simchain.New()
+	require.NoError(t, err)
+	defer func() { _ = chain.Close() }()
+
+	cfg, err := chain.ClientConfig(types.KeyDAOOption(store.NewMemory(nil)))
+	require.NoError(t, err)
+	client := sdk.NewIRISHUBClient(cfg)
+
+	shares, err := crypto.SplitMnemonic(mnemonic, 3, 2)
+	require.NoError(t, err)
+
+	address, e := client.Key.RecoverFromShares("alice", "12345678", shares[1:], types.KeyIndexOption(1))
+	require.NoError(t, e)
+	expected, e := client.Key.Recover("bob", "12345678", mnemonic, types.KeyIndexOption(1))
+	require.NoError(t, e)
+	require.Equal(t, expected, address)
+
+	_, e = client.Key.RecoverFromShares("carol", "12345678", shares[:1])
+	require.Error(t, e)
+
+	armor, e := client.Key.Export("alice", "12345678")
+	require.NoError(t, e)
+	shares, err = crypto.SplitPrivKey(armor, 3, 2)
+	require.NoError(t, err)
+
+	address, e = client.Key.RecoverFromShares("dave", "12345678", shares[:2])
+	require.NoError(t, e)
+	require.Equal(t, expected, address)
+}
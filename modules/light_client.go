@@ -0,0 +1,239 @@
+package modules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/light"
+	"github.com/tendermint/tendermint/light/provider"
+	httpprovider "github.com/tendermint/tendermint/light/provider/http"
+	dbs "github.com/tendermint/tendermint/light/store/db"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	lightDBName        = "light"
+	maxValidatorsPage  = 100
+	providerRetryTimes = 3
+)
+
+var (
+	errLightClientDisabled = errors.New("light client is not enabled")
+	regexpMissingHeight    = regexp.MustCompile(`height \d+ (must be less than or equal to|is not available)`)
+)
+
+// lightClient lazily starts a tendermint light client which verifies the headers of the primary node
+type lightClient struct {
+	sync.Mutex
+	verifier *light.Client
+	cfg      *sdk.LightClientConfig
+	chainID  string
+	primary  sdk.TmClient
+	logger   log.Logger
+}
+
+func newLightClient(cfg sdk.ClientConfig, primary sdk.TmClient, logger log.Logger) *lightClient {
+	return &lightClient{
+		cfg:     cfg.LightClient,
+		chainID: cfg.ChainID,
+		primary: primary,
+		logger:  logger,
+	}
+}
+
+// QueryVerifiedHeader returns the header at the given height (0 for the latest) verified by the light client
+func (l *lightClient) QueryVerifiedHeader(height int64) (*sdk.SignedHeader, error) {
+	client, err := l.client()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if height > 0 {
+		lb, err := client.VerifyLightBlockAtHeight(context.Background(), height, now)
+		if err != nil {
+			return nil, err
+		}
+		return lb.SignedHeader, nil
+	}
+
+	if _, err := client.Update(context.Background(), now); err != nil {
+		return nil, err
+	}
+
+	height, err = client.LastTrustedHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	lb, err := client.TrustedLightBlock(height)
+	if err != nil {
+		return nil, err
+	}
+	return lb.SignedHeader, nil
+}
+
+func (l *lightClient) client() (*light.Client, error) {
+	if l.cfg == nil {
+		return nil, errLightClientDisabled
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	if l.verifier != nil {
+		return l.verifier, nil
+	}
+
+	db, err := dbm.NewGoLevelDB(lightDBName, l.cfg.DBDir)
+	if err != nil {
+		return nil, err
+	}
+
+	primary := tmProvider{chainID: l.chainID, client: l.primary}
+	// the tendermint light client requires a witness, without the configured witnesses the primary
+	// node witnesses itself so the headers are verified but a fork of the primary is not detected
+	witnesses := []provider.Provider{primary}
+	if len(l.cfg.Witnesses) > 0 {
+		witnesses = make([]provider.Provider, len(l.cfg.Witnesses))
+		for i, addr := range l.cfg.Witnesses {
+			witness, err := httpprovider.New(l.chainID, addr)
+			if err != nil {
+				return nil, err
+			}
+			witnesses[i] = witness
+		}
+	} else {
+		l.logger.Error("no witness is configured, fork detection of the light client is disabled")
+	}
+
+	verification := light.SkippingVerification(l.cfg.TrustLevel)
+	if l.cfg.Sequential {
+		verification = light.SequentialVerification()
+	}
+
+	client, err := light.NewClient(
+		context.Background(),
+		l.chainID,
+		light.TrustOptions{
+			Period: l.cfg.TrustingPeriod,
+			Height: l.cfg.TrustedHeight,
+			Hash:   l.cfg.TrustedHash,
+		},
+		primary,
+		witnesses,
+		dbs.New(db, l.chainID),
+		verification,
+		light.Logger(l.logger),
+	)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	l.logger.Info("light client started", "trustedHeight", l.cfg.TrustedHeight)
+	l.verifier = client
+	return client, nil
+}
+
+// tmProvider serves light blocks to the light client through the tendermint rpc client
+type tmProvider struct {
+	chainID string
+	client  sdk.TmClient
+}
+
+func (p tmProvider) ChainID() string {
+	return p.chainID
+}
+
+func (p tmProvider) String() string {
+	return fmt.Sprintf("tmProvider{%s}", p.chainID)
+}
+
+// LightBlock fetches the signed header and the validator set at the given height (0 for the latest)
+func (p tmProvider) LightBlock(ctx context.Context, height int64) (*tmtypes.LightBlock, error) {
+	if height < 0 {
+		return nil, provider.ErrBadLightBlock{Reason: fmt.Errorf("expected height >= 0, got height %d", height)}
+	}
+
+	var h *int64
+	if height > 0 {
+		h = &height
+	}
+
+	var commit *ctypes.ResultCommit
+	err := p.retry(func() (err error) {
+		commit, err = p.client.Commit(ctx, h)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	signedHeader := commit.SignedHeader
+
+	// pin the validator set to the height of the fetched header
+	h = &signedHeader.Height
+	var validators []*tmtypes.Validator
+	for page, perPage := 1, maxValidatorsPage; ; page++ {
+		var res *ctypes.ResultValidators
+		err := p.retry(func() (err error) {
+			res, err = p.client.Validators(ctx, h, &page, &perPage)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		validators = append(validators, res.Validators...)
+		if len(res.Validators) < perPage || len(validators) >= res.Total {
+			break
+		}
+	}
+
+	validatorSet, err := tmtypes.ValidatorSetFromExistingValidators(validators)
+	if err != nil {
+		return nil, provider.ErrBadLightBlock{Reason: err}
+	}
+
+	lb := &tmtypes.LightBlock{
+		SignedHeader: &signedHeader,
+		ValidatorSet: validatorSet,
+	}
+	if err := lb.ValidateBasic(p.chainID); err != nil {
+		return nil, provider.ErrBadLightBlock{Reason: err}
+	}
+	return lb, nil
+}
+
+// ReportEvidence broadcasts the evidence of a misbehavior when the rpc client supports it
+func (p tmProvider) ReportEvidence(ctx context.Context, ev tmtypes.Evidence) error {
+	client, ok := p.client.(rpcclient.EvidenceClient)
+	if !ok {
+		return fmt.Errorf("evidence broadcasting is not supported by %s", p)
+	}
+	_, err := client.BroadcastEvidence(ctx, ev)
+	return err
+}
+
+func (p tmProvider) retry(fn func() error) (err error) {
+	for i := 0; i < providerRetryTimes; i++ {
+		if err = fn(); err == nil {
+			return nil
+		}
+
+		if regexpMissingHeight.MatchString(err.Error()) {
+			return provider.ErrLightBlockNotFound
+		}
+		time.Sleep(time.Duration(i+1) * 500 * time.Millisecond)
+	}
+	return provider.ErrNoResponse
+}
@@ -0,0 +1,68 @@
+package modules
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/light/provider"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestLightClient(t *testing.T) {
+	node := newMockNode(t, nil, nil, nil, nil, nil)
+	client := newMockClient(t, node, true)
+
+	header, err := client.QueryVerifiedHeader(4)
+	require.NoError(t, err)
+	require.Equal(t, node.commits[3].Header.Hash(), header.Hash())
+
+	header, err = client.QueryVerifiedHeader(0)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), header.Height)
+
+	_, err = newMockClient(t, node, false).QueryVerifiedHeader(4)
+	require.Equal(t, errLightClientDisabled, err)
+}
+
+func TestLightClientRejectedHeader(t *testing.T) {
+	node := newMockNode(t, nil, nil, nil, nil, nil)
+	node.tamper(4, func(header *tmtypes.Header) { header.AppHash = []byte("fake") })
+
+	client := newMockClient(t, node, true)
+	_, err := client.QueryVerifiedHeader(4)
+	require.Error(t, err)
+
+	// the untampered headers are still verified
+	header, err := client.QueryVerifiedHeader(3)
+	require.NoError(t, err)
+	require.Equal(t, node.commits[2].Header.Hash(), header.Hash())
+
+	// the trusted header differs from the header of the node
+	node = newMockNode(t, nil, nil, nil)
+	client = newMockClient(t, node, true)
+	client.light.cfg.TrustedHash = tmhash.Sum([]byte("other header"))
+	_, err = client.QueryVerifiedHeader(3)
+	require.Error(t, err)
+}
+
+func TestTmProvider(t *testing.T) {
+	node := newMockNode(t, nil, nil)
+	p := tmProvider{chainID: mockChainID, client: node}
+
+	lb, err := p.LightBlock(context.Background(), 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), lb.Height)
+	require.Equal(t, node.validators.Hash(), lb.ValidatorSet.Hash())
+
+	_, err = p.LightBlock(context.Background(), 3)
+	require.Equal(t, provider.ErrLightBlockNotFound, err)
+
+	_, err = p.LightBlock(context.Background(), -1)
+	require.Error(t, err)
+
+	// the light block of another chain is rejected
+	_, err = tmProvider{chainID: "other", client: node}.LightBlock(context.Background(), 1)
+	require.IsType(t, provider.ErrBadLightBlock{}, err)
+}
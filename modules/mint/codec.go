@@ -0,0 +1,8 @@
+package mint
+
+import (
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+)
+
+// RegisterInterfaces is a no-op, the mint module has no messages
+func RegisterInterfaces(registry types.InterfaceRegistry) {}
@@ -0,0 +1,22 @@
+package mint
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// expose Mint module api for user
+type Client interface {
+	sdk.Module
+
+	QueryParams() (QueryParamsResp, sdk.Error)
+	// QueryInflation returns the annual inflation rate of the mint denom
+	QueryInflation() (sdk.Dec, sdk.Error)
+	// QueryAnnualProvisions returns the amount of the mint denom minted per year, i.e. the inflation
+	// times the inflation base of the minter
+	QueryAnnualProvisions() (sdk.Dec, sdk.Error)
+}
+
+type QueryParamsResp struct {
+	MintDenom string  `json:"mint_denom"`
+	Inflation sdk.Dec `json:"inflation"`
+}
@@ -0,0 +1,79 @@
+package mint
+
+import (
+	"context"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+type mintClient struct {
+	sdk.BaseClient
+	codec.Marshaler
+}
+
+func NewClient(baseClient sdk.BaseClient, marshaler codec.Marshaler) Client {
+	return &mintClient{
+		BaseClient: baseClient,
+		Marshaler:  marshaler,
+	}
+}
+
+func (mc mintClient) Name() string {
+	return ModuleName
+}
+
+func (mc mintClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
+	RegisterInterfaces(registry)
+}
+
+func (mc mintClient) QueryParams() (QueryParamsResp, sdk.Error) {
+	res, err := mc.QueryParamsResponse(context.Background())
+	if err != nil {
+		return QueryParamsResp{}, sdk.Wrap(err)
+	}
+	return res.Convert().(QueryParamsResp), nil
+}
+
+// QueryParamsResponse returns the grpc response of the params at the height of the context
+func (mc mintClient) QueryParamsResponse(ctx context.Context) (sdk.Response, error) {
+	conn, err := mc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewQueryClient(conn).Params(ctx, &QueryParamsRequest{})
+}
+
+func (mc mintClient) QueryInflation() (sdk.Dec, sdk.Error) {
+	params, err := mc.QueryParams()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return params.Inflation, nil
+}
+
+// QueryAnnualProvisions returns the inflation of the params times the inflation base of the minter,
+// the mint module of irishub does not serve the annual provisions so the minter is read from its store
+func (mc mintClient) QueryAnnualProvisions() (sdk.Dec, sdk.Error) {
+	params, err := mc.QueryParams()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	res, e := mc.QueryStore(MinterKey, StoreKey, 0, false)
+	if e != nil {
+		return sdk.Dec{}, sdk.Wrap(e)
+	}
+	if len(res.Value) == 0 {
+		return sdk.Dec{}, sdk.Wrapf("minter not found in the %s store", StoreKey)
+	}
+
+	var minter Minter
+	if e := mc.Marshaler.UnmarshalBinaryBare(res.Value, &minter); e != nil {
+		return sdk.Dec{}, sdk.Wrap(e)
+	}
+	return params.Inflation.MulInt(minter.InflationBase), nil
+}
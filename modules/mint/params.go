@@ -0,0 +1,11 @@
+package mint
+
+import (
+	yaml "gopkg.in/yaml.v2"
+)
+
+// String implements the Stringer interface for a Params object.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
@@ -0,0 +1,16 @@
+package mint
+
+const (
+	ModuleName = "mint"
+	StoreKey   = ModuleName
+)
+
+// MinterKey is the key of the minter in the store of the mint module
+var MinterKey = []byte{0x00}
+
+func (q QueryParamsResponse) Convert() interface{} {
+	return QueryParamsResp{
+		MintDenom: q.Params.MintDenom,
+		Inflation: q.Params.Inflation,
+	}
+}
@@ -0,0 +1,140 @@
+package modules
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"github.com/tendermint/tendermint/version"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/store"
+)
+
+const mockChainID = "mock"
+
+// mockNode is a node of a chain with a single validator, it serves the signed headers committing
+// the given app hashes and answers the abci queries with query
+type mockNode struct {
+	sdk.TmClient
+
+	validators *tmtypes.ValidatorSet
+	commits    []*ctypes.ResultCommit
+	query      func(path string, key tmbytes.HexBytes, height int64) abci.ResponseQuery
+}
+
+// newMockNode returns a node whose header at height i+1 commits appHashes[i]
+func newMockNode(t *testing.T, appHashes ...[]byte) *mockNode {
+	privKey := ed25519.GenPrivKeyFromSecret([]byte("mock validator"))
+	privVal := tmtypes.NewMockPVWithParams(privKey, false, false)
+	validators := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(privKey.PubKey(), 10)})
+
+	node := &mockNode{validators: validators}
+	genesisTime := time.Now().Add(-time.Hour).UTC()
+
+	var lastBlockID tmtypes.BlockID
+	for i, appHash := range appHashes {
+		height := int64(i + 1)
+		header := &tmtypes.Header{
+			Version:            tmversion.Consensus{Block: version.BlockProtocol},
+			ChainID:            mockChainID,
+			Height:             height,
+			Time:               genesisTime.Add(time.Duration(height) * time.Second),
+			LastBlockID:        lastBlockID,
+			ValidatorsHash:     validators.Hash(),
+			NextValidatorsHash: validators.Hash(),
+			AppHash:            appHash,
+			ProposerAddress:    validators.Proposer.Address,
+		}
+
+		blockID := tmtypes.BlockID{
+			Hash:          header.Hash(),
+			PartSetHeader: tmtypes.PartSetHeader{Total: 1, Hash: tmhash.Sum(header.Hash())},
+		}
+		voteSet := tmtypes.NewVoteSet(mockChainID, height, 0, tmproto.PrecommitType, validators)
+		commit, err := tmtypes.MakeCommit(blockID, height, 0, voteSet, []tmtypes.PrivValidator{privVal}, header.Time)
+		require.NoError(t, err)
+
+		node.commits = append(node.commits, ctypes.NewResultCommit(header, commit, true))
+		lastBlockID = blockID
+	}
+	return node
+}
+
+// tamper modifies the header at the height after it has been signed
+func (n *mockNode) tamper(height int64, fn func(header *tmtypes.Header)) {
+	header := *n.commits[height-1].Header
+	fn(&header)
+	n.commits[height-1] = ctypes.NewResultCommit(&header, n.commits[height-1].Commit, true)
+}
+
+func (n *mockNode) latest() int64 {
+	return int64(len(n.commits))
+}
+
+func (n *mockNode) Commit(_ context.Context, height *int64) (*ctypes.ResultCommit, error) {
+	h := n.latest()
+	if height != nil {
+		h = *height
+	}
+	if h < 1 || h > n.latest() {
+		return nil, fmt.Errorf("height %d must be less than or equal to the current blockchain height %d", h, n.latest())
+	}
+	return n.commits[h-1], nil
+}
+
+func (n *mockNode) Validators(_ context.Context, height *int64, _, _ *int) (*ctypes.ResultValidators, error) {
+	h := n.latest()
+	if height != nil {
+		h = *height
+	}
+	return &ctypes.ResultValidators{
+		BlockHeight: h,
+		Validators:  n.validators.Validators,
+		Count:       n.validators.Size(),
+		Total:       n.validators.Size(),
+	}, nil
+}
+
+func (n *mockNode) Status(context.Context) (*ctypes.ResultStatus, error) {
+	return &ctypes.ResultStatus{
+		SyncInfo: ctypes.SyncInfo{LatestBlockHeight: n.latest()},
+	}, nil
+}
+
+func (n *mockNode) ABCIQueryWithOptions(_ context.Context, path string, data tmbytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	return &ctypes.ResultABCIQuery{Response: n.query(path, data, opts.Height)}, nil
+}
+
+// newMockClient returns a base client of the node, the headers are verified by a light client trusting
+// the first header if light is true
+func newMockClient(t *testing.T, node *mockNode, light bool) *baseClient {
+	options := []sdk.Option{
+		sdk.TmClientOption(node),
+		sdk.KeyDAOOption(store.NewMemory(nil)),
+	}
+	if light {
+		options = append(options, sdk.LightClientOption(sdk.LightClientConfig{
+			TrustedHeight:  1,
+			TrustedHash:    node.commits[0].Header.Hash(),
+			TrustingPeriod: 24 * time.Hour,
+			DBDir:          t.TempDir(),
+		}))
+	}
+
+	cfg, err := sdk.NewClientConfig("mock", "mock", mockChainID, options...)
+	require.NoError(t, err)
+	return NewBaseClient(cfg, sdk.EncodingConfig{}, log.NewNopLogger()).(*baseClient)
+}
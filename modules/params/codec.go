@@ -0,0 +1,13 @@
+package params
+
+import (
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+	"github.com/irisnet/irishub-sdk-go/modules/gov"
+)
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*gov.Content)(nil),
+		&ParameterChangeProposal{},
+	)
+}
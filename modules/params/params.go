@@ -0,0 +1,17 @@
+package params
+
+import (
+	yaml "gopkg.in/yaml.v2"
+)
+
+// String implements the Stringer interface.
+func (pcp ParameterChangeProposal) String() string {
+	out, _ := yaml.Marshal(pcp)
+	return string(out)
+}
+
+// String implements the Stringer interface.
+func (pc ParamChange) String() string {
+	out, _ := yaml.Marshal(pc)
+	return string(out)
+}
@@ -0,0 +1,76 @@
+// Package params defines the ParameterChangeProposal of the gov module, which changes the params
+// of the other modules. The key of a param is the key of the param in the subspace of the module,
+// e.g. `MaxValidators` of `staking`, and the value is its amino json encoded value.
+package params
+
+import (
+	"strings"
+
+	"github.com/irisnet/irishub-sdk-go/modules/gov"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	ModuleName = "params"
+
+	// ProposalTypeChange defines the type for a ParameterChangeProposal
+	ProposalTypeChange = "ParameterChange"
+)
+
+// Assert ParameterChangeProposal implements gov.Content at compile-time
+var _ gov.Content = &ParameterChangeProposal{}
+
+func init() {
+	gov.RegisterProposalType(ProposalTypeChange)
+}
+
+// NewParameterChangeProposal creates a new parameter change proposal
+func NewParameterChangeProposal(title, description string, changes []ParamChange) *ParameterChangeProposal {
+	return &ParameterChangeProposal{title, description, changes}
+}
+
+// NewParamChange creates a change of the param of the subspace
+func NewParamChange(subspace, key, value string) ParamChange {
+	return ParamChange{subspace, key, value}
+}
+
+// GetTitle returns the title of a parameter change proposal.
+func (pcp *ParameterChangeProposal) GetTitle() string { return pcp.Title }
+
+// GetDescription returns the description of a parameter change proposal.
+func (pcp *ParameterChangeProposal) GetDescription() string { return pcp.Description }
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (pcp *ParameterChangeProposal) ProposalRoute() string { return ModuleName }
+
+// ProposalType returns the type of a parameter change proposal.
+func (pcp *ParameterChangeProposal) ProposalType() string { return ProposalTypeChange }
+
+// ValidateBasic validates the parameter change proposal
+func (pcp *ParameterChangeProposal) ValidateBasic() error {
+	if err := gov.ValidateAbstract(pcp); err != nil {
+		return err
+	}
+	return ValidateChanges(pcp.Changes)
+}
+
+// ValidateChanges performs basic validation checks over a set of ParamChange. It
+// returns an error if any ParamChange is invalid.
+func ValidateChanges(changes []ParamChange) error {
+	if len(changes) == 0 {
+		return sdk.Wrapf("submitted parameter changes are empty")
+	}
+
+	for _, pc := range changes {
+		if len(strings.TrimSpace(pc.Subspace)) == 0 {
+			return sdk.Wrapf("parameter subspace is empty")
+		}
+		if len(strings.TrimSpace(pc.Key)) == 0 {
+			return sdk.Wrapf("parameter key is empty")
+		}
+		if len(strings.TrimSpace(pc.Value)) == 0 {
+			return sdk.Wrapf("parameter value is empty")
+		}
+	}
+	return nil
+}
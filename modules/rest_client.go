@@ -0,0 +1,342 @@
+package modules
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// httpRules caches the google.api.http rule of every invoked grpc method
+var httpRules sync.Map
+
+type restClient struct {
+	url    string
+	cdc    codec.Marshaler
+	client *http.Client
+}
+
+// NewRESTClient returns a client whose connections serve the generated grpc query clients
+// through the grpc-gateway endpoints of the node lcd
+func NewRESTClient(url string, cdc codec.Marshaler, timeout uint) restClient {
+	return restClient{
+		url:    strings.TrimSuffix(url, "/"),
+		cdc:    cdc,
+		client: &http.Client{Timeout: time.Duration(timeout) * time.Second},
+	}
+}
+
+func (r restClient) GenConn() (sdk.QueryConn, error) {
+	return restConn(r), nil
+}
+
+// restConn implements the grpc ClientConn used by the generated query clients by
+// translating every unary call into the http request of its google.api.http rule
+type restConn restClient
+
+// Invoke performs a unary call through the grpc-gateway
+func (r restConn) Invoke(ctx context.Context, method string, args, reply interface{}, _ ...grpc.CallOption) error {
+	req, ok := args.(proto.Message)
+	if !ok {
+		return fmt.Errorf("unsupported request type %T", args)
+	}
+
+	res, ok := reply.(proto.Message)
+	if !ok {
+		return fmt.Errorf("unsupported response type %T", reply)
+	}
+
+	rule, err := httpRule(method, req)
+	if err != nil {
+		return err
+	}
+
+	httpReq, err := r.newRequest(ctx, rule, req)
+	if err != nil {
+		return err
+	}
+
+	httpRes, err := r.client.Do(httpReq)
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	defer func() { _ = httpRes.Body.Close() }()
+
+	bz, err := ioutil.ReadAll(httpRes.Body)
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	if httpRes.StatusCode != http.StatusOK {
+		return gatewayError(httpRes.StatusCode, bz)
+	}
+	return r.cdc.UnmarshalJSON(bz, res)
+}
+
+// NewStream is not supported by the grpc-gateway
+func (r restConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Error(codes.Unimplemented, "streaming is not supported by the rest transport")
+}
+
+func (r restConn) Close() error {
+	return nil
+}
+
+// newRequest fills the path template of the rule with the request fields, the remaining
+// fields are sent as query parameters or as the body of the request
+func (r restConn) newRequest(ctx context.Context, rule *annotations.HttpRule, req proto.Message) (*http.Request, error) {
+	bz, err := r.cdc.MarshalJSON(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	flatten("", fields, params)
+
+	httpMethod, template := ruleMethod(rule)
+	path, err := expandTemplate(template, params)
+	if err != nil {
+		return nil, err
+	}
+	omitDefaults(params)
+
+	var body []byte
+	switch rule.Body {
+	case "":
+	case "*":
+		body, params = bz, url.Values{}
+	default:
+		body, err = json.Marshal(fields[rule.Body])
+		if err != nil {
+			return nil, err
+		}
+		for key := range params {
+			if key == rule.Body || strings.HasPrefix(key, rule.Body+".") {
+				params.Del(key)
+			}
+		}
+	}
+
+	reqURL := r.url + path
+	if query := params.Encode(); len(query) > 0 {
+		reqURL += "?" + query
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, httpMethod, reqURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+
+	// the grpc metadata, e.g. the height of the query, is sent as the headers
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		for key, values := range md {
+			for _, value := range values {
+				httpReq.Header.Add(key, value)
+			}
+		}
+	}
+	return httpReq, nil
+}
+
+// httpRule returns the google.api.http rule of the grpc method, it is read from the file
+// descriptor of the request which is declared in the same file as the service
+func httpRule(method string, req proto.Message) (*annotations.HttpRule, error) {
+	if rule, ok := httpRules.Load(method); ok {
+		return rule.(*annotations.HttpRule), nil
+	}
+
+	// method is formatted as /package.Service/Method
+	parts := strings.Split(strings.TrimPrefix(method, "/"), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid grpc method %s", method)
+	}
+
+	msg, ok := req.(interface{ Descriptor() ([]byte, []int) })
+	if !ok {
+		return nil, fmt.Errorf("missing descriptor of %s", proto.MessageName(req))
+	}
+	gzipped, _ := msg.Descriptor()
+
+	fd, err := unzipFileDescriptor(gzipped)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, service := range fd.Service {
+		if fmt.Sprintf("%s.%s", fd.GetPackage(), service.GetName()) != parts[0] {
+			continue
+		}
+
+		for _, m := range service.Method {
+			if m.GetName() != parts[1] || m.Options == nil {
+				continue
+			}
+
+			rule, ok := protov2.GetExtension(m.Options, annotations.E_Http).(*annotations.HttpRule)
+			if !ok || rule == nil {
+				break
+			}
+			httpRules.Store(method, rule)
+			return rule, nil
+		}
+	}
+	return nil, fmt.Errorf("no http rule is defined for %s", method)
+}
+
+func unzipFileDescriptor(gzipped []byte) (*descriptorpb.FileDescriptorProto, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(gzipped))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = reader.Close() }()
+
+	bz, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	var fd descriptorpb.FileDescriptorProto
+	if err := protov2.Unmarshal(bz, &fd); err != nil {
+		return nil, err
+	}
+	return &fd, nil
+}
+
+func ruleMethod(rule *annotations.HttpRule) (string, string) {
+	switch pattern := rule.Pattern.(type) {
+	case *annotations.HttpRule_Get:
+		return http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Post:
+		return http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Put:
+		return http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Delete:
+		return http.MethodDelete, pattern.Delete
+	case *annotations.HttpRule_Patch:
+		return http.MethodPatch, pattern.Patch
+	case *annotations.HttpRule_Custom:
+		return pattern.Custom.Kind, pattern.Custom.Path
+	default:
+		return http.MethodGet, ""
+	}
+}
+
+// expandTemplate replaces the variables of the path template, e.g. `{address}` or `{name=**}`,
+// with the request fields and removes them from the query parameters
+func expandTemplate(template string, params url.Values) (string, error) {
+	var path strings.Builder
+	for {
+		begin := strings.Index(template, "{")
+		if begin < 0 {
+			path.WriteString(template)
+			return path.String(), nil
+		}
+
+		end := strings.Index(template, "}")
+		if end < begin {
+			return "", fmt.Errorf("invalid path template %s", template)
+		}
+
+		field := strings.SplitN(template[begin+1:end], "=", 2)[0]
+		value := params.Get(field)
+		if len(value) == 0 {
+			return "", fmt.Errorf("missing path parameter %s", field)
+		}
+		params.Del(field)
+
+		path.WriteString(template[:begin])
+		path.WriteString(url.PathEscape(value))
+		template = template[end+1:]
+	}
+}
+
+// flatten converts the proto-json fields into query parameters, nested fields are joined with dots.
+// The default values are kept so that they can fill the path template, e.g. a proposal id 0.
+func flatten(prefix string, value interface{}, params url.Values) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if len(prefix) > 0 {
+				key = prefix + "." + key
+			}
+			flatten(key, field, params)
+		}
+	case []interface{}:
+		for _, item := range v {
+			flatten(prefix, item, params)
+		}
+	case string:
+		params.Add(prefix, v)
+	case bool:
+		params.Add(prefix, strconv.FormatBool(v))
+	case float64:
+		params.Add(prefix, strconv.FormatFloat(v, 'f', -1, 64))
+	}
+}
+
+// omitDefaults removes the query parameters whose values are all defaults, which the grpc-gateway
+// reads as unset
+func omitDefaults(params url.Values) {
+	for key, values := range params {
+		omit := true
+		for _, value := range values {
+			if len(value) > 0 && value != "0" && value != "false" {
+				omit = false
+				break
+			}
+		}
+		if omit {
+			params.Del(key)
+		}
+	}
+}
+
+// gatewayError converts the error returned by the grpc-gateway into a grpc status error, the routes
+// unknown to the gateway, e.g. the queries of a module missing on the node, are unimplemented
+func gatewayError(statusCode int, bz []byte) error {
+	var res struct {
+		Code    codes.Code `json:"code"`
+		Message string     `json:"message"`
+		Error   string     `json:"error"`
+	}
+	if err := json.Unmarshal(bz, &res); err != nil || res.Code == codes.OK {
+		code := codes.Unknown
+		if statusCode == http.StatusNotFound || statusCode == http.StatusNotImplemented {
+			code = codes.Unimplemented
+		}
+		return status.Error(code, fmt.Sprintf("unexpected status %d: %s", statusCode, strings.TrimSpace(string(bz))))
+	}
+
+	if len(res.Message) == 0 {
+		res.Message = res.Error
+	}
+	return status.Error(res.Code, res.Message)
+}
@@ -0,0 +1,123 @@
+package modules
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	codectypes "github.com/irisnet/irishub-sdk-go/codec/types"
+	"github.com/irisnet/irishub-sdk-go/modules/bank"
+	"github.com/irisnet/irishub-sdk-go/modules/gov"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/query"
+)
+
+const restAddress = "iaa1qzds87rxyrv4ak9gr2mx9ptjhsqs5thcha0e5n"
+
+// newRESTServer returns a client of a grpc-gateway answering every request with the status and the body
+// of respond, the last request is recorded into req
+func newRESTServer(t *testing.T, req **http.Request, respond func() (int, string)) restConn {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*req = r
+		code, body := respond()
+		w.WriteHeader(code)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	conn, err := NewRESTClient(server.URL+"/", codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), 5).GenConn()
+	require.NoError(t, err)
+	return conn.(restConn)
+}
+
+func TestRESTClient(t *testing.T) {
+	var req *http.Request
+	conn := newRESTServer(t, &req, func() (int, string) {
+		return http.StatusOK, `{"balances":[{"denom":"uiris","amount":"100"}],"pagination":{"total":"1"}}`
+	})
+
+	// the path template is filled with the address, the other fields are sent as query parameters
+	ctx := sdk.ContextWithHeight(context.Background(), 10)
+	res, err := bank.NewQueryClient(conn).AllBalances(ctx, &bank.QueryAllBalancesRequest{
+		Address:    restAddress,
+		Pagination: &query.PageRequest{Offset: 0, Limit: 20, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "100uiris", res.Balances.String())
+	require.Equal(t, uint64(1), res.Pagination.Total)
+
+	require.Equal(t, http.MethodGet, req.Method)
+	require.Equal(t, "/cosmos/bank/v1beta1/balances/"+restAddress, req.URL.Path)
+	require.Equal(t, url.Values{
+		"pagination.limit":       {"20"},
+		"pagination.count_total": {"true"},
+	}, req.URL.Query())
+	require.Equal(t, "10", req.Header.Get(sdk.GRPCBlockHeightHeader))
+
+	// a path parameter can be the default value
+	_, _ = gov.NewQueryClient(conn).Proposal(context.Background(), &gov.QueryProposalRequest{ProposalId: 0})
+	require.Equal(t, "/cosmos/gov/v1beta1/proposals/0", req.URL.Path)
+
+	_, _ = gov.NewQueryClient(conn).Vote(context.Background(), &gov.QueryVoteRequest{ProposalId: 3, Voter: restAddress})
+	require.Equal(t, "/cosmos/gov/v1beta1/proposals/3/votes/"+restAddress, req.URL.Path)
+	require.Empty(t, req.URL.RawQuery)
+
+	// an empty path parameter is missing
+	_, err = bank.NewQueryClient(conn).AllBalances(context.Background(), &bank.QueryAllBalancesRequest{})
+	require.Error(t, err)
+}
+
+func TestRESTClientErrors(t *testing.T) {
+	code, body := http.StatusOK, ""
+	var req *http.Request
+	conn := newRESTServer(t, &req, func() (int, string) { return code, body })
+
+	testCases := []struct {
+		code     int
+		body     string
+		expected codes.Code
+	}{
+		{http.StatusNotFound, `{"code":5,"message":"account not found"}`, codes.NotFound},
+		{http.StatusBadRequest, `{"code":3,"error":"invalid address"}`, codes.InvalidArgument},
+		{http.StatusInternalServerError, `internal error`, codes.Unknown},
+		{http.StatusInternalServerError, `{"code":0}`, codes.Unknown},
+		// the unknown routes of the gateway
+		{http.StatusNotFound, "Not Found\n", codes.Unimplemented},
+		{http.StatusNotImplemented, "Not Implemented", codes.Unimplemented},
+		{http.StatusNotImplemented, `{"code":12,"message":"unknown method"}`, codes.Unimplemented},
+	}
+	for _, tc := range testCases {
+		code, body = tc.code, tc.body
+		_, err := bank.NewQueryClient(conn).Params(context.Background(), &bank.QueryParamsRequest{})
+		require.Equal(t, tc.expected, status.Code(err), tc.body)
+	}
+
+	st, _ := status.FromError(gatewayError(http.StatusBadRequest, []byte(`{"code":3,"error":"invalid address"}`)))
+	require.Equal(t, "invalid address", st.Message())
+}
+
+func TestFlatten(t *testing.T) {
+	params := url.Values{}
+	flatten("", map[string]interface{}{
+		"height": float64(1e21),
+		"rate":   0.5,
+		"pagination": map[string]interface{}{
+			"count_total": true,
+			"key":         "AA==",
+		},
+		"ids": []interface{}{float64(1), float64(2)},
+	}, params)
+
+	require.Equal(t, "1000000000000000000000", params.Get("height"))
+	require.Equal(t, "0.5", params.Get("rate"))
+	require.Equal(t, "true", params.Get("pagination.count_total"))
+	require.Equal(t, "AA==", params.Get("pagination.key"))
+	require.Equal(t, []string{"1", "2"}, params["ids"])
+}
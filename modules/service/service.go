@@ -668,21 +668,22 @@ func (s serviceClient) QueryFees(provider string) (sdk.Coins, sdk.Error) {
 }
 
 func (s serviceClient) QueryParams() (QueryParamsResp, sdk.Error) {
-	conn, err := s.GenConn()
-	defer func() { _ = conn.Close() }()
+	res, err := s.QueryParamsResponse(context.Background())
 	if err != nil {
 		return QueryParamsResp{}, sdk.Wrap(err)
 	}
+	return res.Convert().(QueryParamsResp), nil
+}
 
-	res, err := NewQueryClient(conn).Params(
-		context.Background(),
-		&QueryParamsRequest{},
-	)
+// QueryParamsResponse returns the grpc response of the params at the height of the context
+func (s serviceClient) QueryParamsResponse(ctx context.Context) (sdk.Response, error) {
+	conn, err := s.GenConn()
+	defer func() { _ = conn.Close() }()
 	if err != nil {
-		return QueryParamsResp{}, sdk.Wrap(err)
+		return nil, err
 	}
 
-	return res.Params.Convert().(QueryParamsResp), nil
+	return NewQueryClient(conn).Params(ctx, &QueryParamsRequest{})
 }
 
 func (s serviceClient) GenServiceResponseMsgs(events sdk.StringEvents, serviceName string,
@@ -820,3 +820,7 @@ func (p Params) Convert() interface{} {
 		BaseDenom:            p.BaseDenom,
 	}
 }
+
+func (q QueryParamsResponse) Convert() interface{} {
+	return q.Params.Convert()
+}
@@ -0,0 +1,11 @@
+package slashing
+
+import (
+	yaml "gopkg.in/yaml.v2"
+)
+
+// String implements the Stringer interface for a ValidatorSigningInfo object.
+func (i ValidatorSigningInfo) String() string {
+	out, _ := yaml.Marshal(i)
+	return string(out)
+}
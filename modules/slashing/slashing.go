@@ -0,0 +1,105 @@
+package slashing
+
+import (
+	"context"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/query"
+	"github.com/irisnet/irishub-sdk-go/utils"
+)
+
+type slashingClient struct {
+	sdk.BaseClient
+	codec.Marshaler
+}
+
+func NewClient(baseClient sdk.BaseClient, marshaler codec.Marshaler) Client {
+	return &slashingClient{
+		BaseClient: baseClient,
+		Marshaler:  marshaler,
+	}
+}
+
+func (sc slashingClient) Name() string {
+	return ModuleName
+}
+
+func (sc slashingClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
+	RegisterInterfaces(registry)
+}
+
+func (sc slashingClient) Unjail(baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	operatorAddr, err := sc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msg := &MsgUnjail{
+		ValidatorAddr: sdk.ValAddress(operatorAddr.Bytes()).String(),
+	}
+	return sc.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+func (sc slashingClient) QuerySigningInfo(consAddress string) (QuerySigningInfoResp, sdk.Error) {
+	conn, err := sc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return QuerySigningInfoResp{}, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).SigningInfo(
+		context.Background(),
+		&QuerySigningInfoRequest{
+			ConsAddress: consAddress,
+		},
+	)
+	if err != nil {
+		return QuerySigningInfoResp{}, sdk.Wrap(err)
+	}
+	return res.ValSigningInfo.Convert().(QuerySigningInfoResp), nil
+}
+
+func (sc slashingClient) QuerySigningInfos(page, size uint64) (QuerySigningInfosResp, sdk.Error) {
+	conn, err := sc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return QuerySigningInfosResp{}, sdk.Wrap(err)
+	}
+
+	offset, limit := utils.ParsePage(page, size)
+	res, err := NewQueryClient(conn).SigningInfos(
+		context.Background(),
+		&QuerySigningInfosRequest{
+			Pagination: &query.PageRequest{
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: true,
+			},
+		},
+	)
+	if err != nil {
+		return QuerySigningInfosResp{}, sdk.Wrap(err)
+	}
+	return res.Convert().(QuerySigningInfosResp), nil
+}
+
+func (sc slashingClient) QueryParams() (QueryParamsResp, sdk.Error) {
+	res, err := sc.QueryParamsResponse(context.Background())
+	if err != nil {
+		return QueryParamsResp{}, sdk.Wrap(err)
+	}
+	return res.Convert().(QueryParamsResp), nil
+}
+
+// QueryParamsResponse returns the grpc response of the params at the height of the context
+func (sc slashingClient) QueryParamsResponse(ctx context.Context) (sdk.Response, error) {
+	conn, err := sc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewQueryClient(conn).Params(ctx, &QueryParamsRequest{})
+}
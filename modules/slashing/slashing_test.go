@@ -0,0 +1,126 @@
+package slashing_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/irisnet/irishub-sdk-go"
+	codectypes "github.com/irisnet/irishub-sdk-go/codec/types"
+	"github.com/irisnet/irishub-sdk-go/crypto"
+	"github.com/irisnet/irishub-sdk-go/crypto/keys/ed25519"
+	"github.com/irisnet/irishub-sdk-go/modules/slashing"
+	"github.com/irisnet/irishub-sdk-go/modules/staking"
+	"github.com/irisnet/irishub-sdk-go/testutil/simchain"
+	"github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/store"
+)
+
+const (
+	name     = "alice"
+	password = "12345678"
+	mnemonic = "nerve leader thank marriage spice task van start piece crowd run hospital control outside cousin romance left choice poet wagon rude climb leisure spring"
+)
+
+func TestSlashing(t *testing.T) {
+	km, err := crypto.NewMnemonicKeyManager(mnemonic, "secp256k1")
+	require.NoError(t, err)
+	operator := types.AccAddress(km.ExportPubKey().Address()).String()
+
+	consPubKey := ed25519.GenPrivKeyFromSecret([]byte("alpha")).PubKey()
+	consAddress := types.ConsAddress(consPubKey.Address()).String()
+	pubKey, err := codectypes.NewAnyWithValue(consPubKey.(*ed25519.PubKey))
+	require.NoError(t, err)
+
+	jailedUntil := time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)
+	infos := []slashing.ValidatorSigningInfo{
+		{
+			Address:             consAddress,
+			StartHeight:         1,
+			IndexOffset:         50,
+			JailedUntil:         jailedUntil,
+			MissedBlocksCounter: 60,
+		},
+		{
+			Address:    types.ConsAddress(ed25519.GenPrivKeyFromSecret([]byte("beta")).PubKey().Address()).String(),
+			Tombstoned: true,
+		},
+	}
+
+	client := newClient(t,
+		simchain.BalanceOption(operator, types.NewInt64Coin("uiris", 100000000)),
+		simchain.ValidatorOption(staking.Validator{
+			OperatorAddress: types.ValAddress(km.ExportPubKey().Address()).String(),
+			ConsensusPubkey: pubKey,
+			Jailed:          true,
+			Status:          staking.Unbonding,
+			Tokens:          types.NewInt(100),
+			DelegatorShares: types.NewDec(100),
+		}),
+		simchain.SigningInfoOption(infos...),
+	)
+
+	params, err := client.Slashing.QueryParams()
+	require.NoError(t, err)
+	require.Equal(t, int64(100), params.SignedBlocksWindow)
+	require.Equal(t, types.NewDecWithPrec(5, 1), params.MinSignedPerWindow)
+	require.Equal(t, 10*time.Minute, params.DowntimeJailDuration)
+	require.Equal(t, types.NewDecWithPrec(5, 2), params.SlashFractionDoubleSign)
+	require.Equal(t, types.NewDecWithPrec(1, 2), params.SlashFractionDowntime)
+
+	info, err := client.Slashing.QuerySigningInfo(consAddress)
+	require.NoError(t, err)
+	require.Equal(t, slashing.QuerySigningInfoResp{
+		Address:             consAddress,
+		StartHeight:         1,
+		IndexOffset:         50,
+		JailedUntil:         jailedUntil,
+		MissedBlocksCounter: 60,
+	}, info)
+
+	_, err = client.Slashing.QuerySigningInfo(types.ConsAddress(ed25519.GenPrivKeyFromSecret([]byte("gamma")).PubKey().Address()).String())
+	require.Error(t, err)
+
+	// the signing infos are paginated by their address
+	page, err := client.Slashing.QuerySigningInfos(1, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), page.Total)
+	require.Len(t, page.SigningInfos, 1)
+
+	next, err := client.Slashing.QuerySigningInfos(2, 1)
+	require.NoError(t, err)
+	require.Len(t, next.SigningInfos, 1)
+	require.NotEqual(t, page.SigningInfos[0].Address, next.SigningInfos[0].Address)
+
+	address, err := client.Key.Recover(name, password, mnemonic)
+	require.NoError(t, err)
+	require.Equal(t, operator, address)
+
+	baseTx := types.BaseTx{From: name, Password: password}
+	_, err = client.Slashing.Unjail(baseTx)
+	require.NoError(t, err)
+
+	validators, err := client.Staking.QueryValidators(staking.Unbonding.String(), 1, 10)
+	require.NoError(t, err)
+	require.Len(t, validators.Validators, 1)
+	require.False(t, validators.Validators[0].Jailed)
+
+	// a validator is unjailed once
+	_, err = client.Slashing.Unjail(baseTx)
+	require.Error(t, err)
+}
+
+// newClient returns a client committing the transactions to a new chain of the options,
+// the chain is closed when the test finishes
+func newClient(t *testing.T, options ...simchain.Option) sdk.IRISHUBClient {
+	t.Helper()
+
+	chain, err := simchain.New(options...)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = chain.Close() })
+
+	cfg, err := chain.ClientConfig(types.KeyDAOOption(store.NewMemory(nil)), types.ModeOption(types.Commit))
+	require.NoError(t, err)
+	return sdk.NewIRISHUBClient(cfg)
+}
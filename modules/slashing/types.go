@@ -0,0 +1,74 @@
+package slashing
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	ModuleName = "slashing"
+
+	TypeMsgUnjail = "unjail"
+)
+
+var _ sdk.Msg = &MsgUnjail{}
+
+func (msg MsgUnjail) Route() string { return ModuleName }
+
+func (msg MsgUnjail) Type() string { return TypeMsgUnjail }
+
+func (msg MsgUnjail) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddr)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{valAddr.Bytes()}
+}
+
+func (msg MsgUnjail) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgUnjail) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddr); err != nil {
+		return sdk.Wrapf("invalid validatorAddr")
+	}
+	return nil
+}
+
+func (i ValidatorSigningInfo) Convert() interface{} {
+	return QuerySigningInfoResp{
+		Address:             i.Address,
+		StartHeight:         i.StartHeight,
+		IndexOffset:         i.IndexOffset,
+		JailedUntil:         i.JailedUntil,
+		Tombstoned:          i.Tombstoned,
+		MissedBlocksCounter: i.MissedBlocksCounter,
+	}
+}
+
+func (q QuerySigningInfosResponse) Convert() interface{} {
+	var infos []QuerySigningInfoResp
+	for _, info := range q.Info {
+		infos = append(infos, info.Convert().(QuerySigningInfoResp))
+	}
+
+	var total uint64
+	if q.Pagination != nil {
+		total = q.Pagination.Total
+	}
+	return QuerySigningInfosResp{
+		SigningInfos: infos,
+		Total:        total,
+	}
+}
+
+func (q QueryParamsResponse) Convert() interface{} {
+	return QueryParamsResp{
+		SignedBlocksWindow:      q.Params.SignedBlocksWindow,
+		MinSignedPerWindow:      q.Params.MinSignedPerWindow,
+		DowntimeJailDuration:    q.Params.DowntimeJailDuration,
+		SlashFractionDoubleSign: q.Params.SlashFractionDoubleSign,
+		SlashFractionDowntime:   q.Params.SlashFractionDowntime,
+	}
+}
@@ -452,18 +452,20 @@ func (sc stakingClient) QueryPool() (QueryPoolResp, sdk.Error) {
 }
 
 func (sc stakingClient) QueryParams() (QueryParamsResp, sdk.Error) {
-	conn, err := sc.GenConn()
-	defer func() { _ = conn.Close() }()
+	res, err := sc.QueryParamsResponse(context.Background())
 	if err != nil {
 		return QueryParamsResp{}, sdk.Wrap(err)
 	}
+	return res.Convert().(QueryParamsResp), nil
+}
 
-	res, err := NewQueryClient(conn).Params(
-		context.Background(),
-		&QueryParamsRequest{},
-	)
+// QueryParamsResponse returns the grpc response of the params at the height of the context
+func (sc stakingClient) QueryParamsResponse(ctx context.Context) (sdk.Response, error) {
+	conn, err := sc.GenConn()
+	defer func() { _ = conn.Close() }()
 	if err != nil {
-		return QueryParamsResp{}, sdk.Wrap(err)
+		return nil, err
 	}
-	return res.Convert().(QueryParamsResp), nil
+
+	return NewQueryClient(conn).Params(ctx, &QueryParamsRequest{})
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/irisnet/irishub-sdk-go/codec"
 	"github.com/irisnet/irishub-sdk-go/modules/token"
+	"github.com/irisnet/irishub-sdk-go/modules/transfer"
 	sdk "github.com/irisnet/irishub-sdk-go/types"
 	"github.com/irisnet/irishub-sdk-go/utils/cache"
 )
@@ -22,6 +23,10 @@ type tokenQuery struct {
 }
 
 func (l tokenQuery) QueryToken(denom string) (sdk.Token, error) {
+	if sdk.IsIBCDenom(denom) {
+		return l.queryIBCToken(denom)
+	}
+
 	denom = strings.ToLower(denom)
 	if t, err := l.Get(l.prefixKey(denom)); err == nil {
 		return t.(sdk.Token), nil
@@ -50,6 +55,75 @@ func (l tokenQuery) QueryToken(denom string) (sdk.Token, error) {
 	return token, nil
 }
 
+// queryIBCToken returns the token of an ibc voucher, which is denominated by its ibc denom in both
+// units with the scale of its base denom, so that its main coins stay distinct from the base token
+// and convert back to the voucher. The scale is 0 if the base denom is not issued on this chain
+func (l tokenQuery) queryIBCToken(denom string) (sdk.Token, error) {
+	hash, err := sdk.ParseIBCDenomHash(denom)
+	if err != nil {
+		return sdk.Token{}, sdk.Wrap(err)
+	}
+
+	ibcDenom := sdk.IBCDenomPrefix + hash.String()
+	if t, err := l.Get(l.prefixKey(ibcDenom)); err == nil {
+		return t.(sdk.Token), nil
+	}
+
+	trace, err := l.QueryDenomTrace(ibcDenom)
+	if err != nil {
+		return sdk.Token{}, err
+	}
+
+	ibcToken := sdk.Token{Name: trace.FullPath()}
+	if baseToken, err := l.QueryToken(trace.BaseDenom); err == nil {
+		ibcToken = baseToken
+	} else {
+		l.Debug("token of the ibc denom not found", "denom", ibcDenom, "base_denom", trace.BaseDenom)
+	}
+	ibcToken.Symbol = ibcDenom
+	ibcToken.MinUnit = ibcDenom
+
+	if err := l.Set(l.prefixKey(ibcDenom), ibcToken); err != nil {
+		l.Debug("cache token failed", "symbol", ibcDenom)
+	}
+	return ibcToken, nil
+}
+
+func (l tokenQuery) QueryDenomTrace(denom string) (sdk.DenomTrace, error) {
+	hash, err := sdk.ParseIBCDenomHash(denom)
+	if err != nil {
+		return sdk.DenomTrace{}, sdk.Wrap(err)
+	}
+
+	key := l.denomTraceKey(hash.String())
+	if t, err := l.Get(key); err == nil {
+		return t.(sdk.DenomTrace), nil
+	}
+
+	conn, err := l.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return sdk.DenomTrace{}, sdk.Wrap(err)
+	}
+
+	response, err := transfer.NewQueryClient(conn).DenomTrace(
+		context.Background(),
+		&transfer.QueryDenomTraceRequest{Hash: hash.String()},
+	)
+	if err != nil {
+		return sdk.DenomTrace{}, sdk.Wrap(err)
+	}
+	if response.DenomTrace == nil {
+		return sdk.DenomTrace{}, sdk.Wrapf("denom trace of %s not found", denom)
+	}
+
+	trace := response.DenomTrace.Convert().(sdk.DenomTrace)
+	if err := l.Set(key, trace); err != nil {
+		l.Debug("cache denom trace failed", "hash", hash.String())
+	}
+	return trace, nil
+}
+
 func (l tokenQuery) SaveTokens(tokens ...sdk.Token) {
 	for _, t := range tokens {
 		err1 := l.Set(l.prefixKey(t.Symbol), t)
@@ -95,3 +169,7 @@ func (l tokenQuery) ToMainCoin(coins ...sdk.Coin) (dstCoins sdk.DecCoins, err sd
 func (l tokenQuery) prefixKey(symbol string) string {
 	return fmt.Sprintf("token:%s", symbol)
 }
+
+func (l tokenQuery) denomTraceKey(hash string) string {
+	return fmt.Sprintf("denom_trace:%s", hash)
+}
@@ -174,19 +174,20 @@ func (t tokenClient) QueryFees(symbol string) (QueryFeesResp, error) {
 }
 
 func (t tokenClient) QueryParams() (QueryParamsResp, error) {
-	conn, err := t.GenConn()
-	defer func() { _ = conn.Close() }()
+	res, err := t.QueryParamsResponse(context.Background())
 	if err != nil {
 		return QueryParamsResp{}, sdk.Wrap(err)
 	}
+	return res.Convert().(QueryParamsResp), nil
+}
 
-	res, err := NewQueryClient(conn).Params(
-		context.Background(),
-		&QueryParamsRequest{},
-	)
+// QueryParamsResponse returns the grpc response of the params at the height of the context
+func (t tokenClient) QueryParamsResponse(ctx context.Context) (sdk.Response, error) {
+	conn, err := t.GenConn()
+	defer func() { _ = conn.Close() }()
 	if err != nil {
-		return QueryParamsResp{}, sdk.Wrap(err)
+		return nil, err
 	}
 
-	return res.Params.Convert().(QueryParamsResp), nil
+	return NewQueryClient(conn).Params(ctx, &QueryParamsRequest{})
 }
@@ -315,3 +315,7 @@ func (p Params) Convert() interface{} {
 func (t QueryFeesResponse) Convert() interface{} {
 	return QueryFeesResp(t)
 }
+
+func (q QueryParamsResponse) Convert() interface{} {
+	return q.Params.Convert()
+}
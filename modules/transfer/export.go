@@ -0,0 +1,42 @@
+package transfer
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// expose IBC Transfer module api for user
+type Client interface {
+	sdk.Module
+
+	// Transfer sends the token to the receiver on the chain at the other end of the channel
+	Transfer(request TransferRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+
+	// QueryDenomTrace returns the trace of the ibc denom ibc/{hash} or of the hash, the traces are cached
+	QueryDenomTrace(denom string) (sdk.DenomTrace, sdk.Error)
+	QueryDenomTraces(page, size uint64) (QueryDenomTracesResp, sdk.Error)
+	QueryParams() (QueryParamsResp, sdk.Error)
+}
+
+// TransferRequest transfers the token through the channel of the port, the packet times out at
+// the timeout height of the destination chain or at the timeout timestamp, at least one of them is required
+type TransferRequest struct {
+	// SourcePort defaults to PortID
+	SourcePort    string      `json:"source_port"`
+	SourceChannel string      `json:"source_channel"`
+	Token         sdk.DecCoin `json:"token"`
+	Receiver      string      `json:"receiver"`
+	// TimeoutHeight is the height of the destination chain, disabled if zero
+	TimeoutHeight Height `json:"timeout_height"`
+	// TimeoutTimestamp is the unix time of the destination chain in nanoseconds, disabled if zero
+	TimeoutTimestamp uint64 `json:"timeout_timestamp"`
+}
+
+type QueryDenomTracesResp struct {
+	DenomTraces []sdk.DenomTrace `json:"denom_traces"`
+	Total       uint64           `json:"total"`
+}
+
+type QueryParamsResp struct {
+	SendEnabled    bool `json:"send_enabled"`
+	ReceiveEnabled bool `json:"receive_enabled"`
+}
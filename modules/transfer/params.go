@@ -0,0 +1,10 @@
+package transfer
+
+import (
+	"fmt"
+)
+
+// String implements the Stringer interface for a Height object.
+func (h Height) String() string {
+	return fmt.Sprintf("%d-%d", h.RevisionNumber, h.RevisionHeight)
+}
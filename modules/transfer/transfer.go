@@ -0,0 +1,110 @@
+package transfer
+
+import (
+	"context"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/query"
+	"github.com/irisnet/irishub-sdk-go/utils"
+)
+
+type transferClient struct {
+	sdk.BaseClient
+	codec.Marshaler
+}
+
+func NewClient(baseClient sdk.BaseClient, marshaler codec.Marshaler) Client {
+	return &transferClient{
+		BaseClient: baseClient,
+		Marshaler:  marshaler,
+	}
+}
+
+func (tc transferClient) Name() string {
+	return ModuleName
+}
+
+func (tc transferClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
+	RegisterInterfaces(registry)
+}
+
+func (tc transferClient) Transfer(request TransferRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	sender, err := tc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	coins, err := tc.ToMinCoin(request.Token)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	sourcePort := request.SourcePort
+	if len(sourcePort) == 0 {
+		sourcePort = PortID
+	}
+
+	msg := &MsgTransfer{
+		SourcePort:       sourcePort,
+		SourceChannel:    request.SourceChannel,
+		Token:            coins[0],
+		Sender:           sender.String(),
+		Receiver:         request.Receiver,
+		TimeoutHeight:    request.TimeoutHeight,
+		TimeoutTimestamp: request.TimeoutTimestamp,
+	}
+	return tc.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+func (tc transferClient) QueryDenomTrace(denom string) (sdk.DenomTrace, sdk.Error) {
+	trace, err := tc.BaseClient.QueryDenomTrace(denom)
+	if err != nil {
+		return sdk.DenomTrace{}, sdk.Wrap(err)
+	}
+	return trace, nil
+}
+
+func (tc transferClient) QueryDenomTraces(page, size uint64) (QueryDenomTracesResp, sdk.Error) {
+	conn, err := tc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return QueryDenomTracesResp{}, sdk.Wrap(err)
+	}
+
+	offset, limit := utils.ParsePage(page, size)
+	res, err := NewQueryClient(conn).DenomTraces(
+		context.Background(),
+		&QueryDenomTracesRequest{
+			Pagination: &query.PageRequest{
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: true,
+			},
+		},
+	)
+	if err != nil {
+		return QueryDenomTracesResp{}, sdk.Wrap(err)
+	}
+	return res.Convert().(QueryDenomTracesResp), nil
+}
+
+func (tc transferClient) QueryParams() (QueryParamsResp, sdk.Error) {
+	res, err := tc.QueryParamsResponse(context.Background())
+	if err != nil {
+		return QueryParamsResp{}, sdk.Wrap(err)
+	}
+	return res.Convert().(QueryParamsResp), nil
+}
+
+// QueryParamsResponse returns the grpc response of the params at the height of the context
+func (tc transferClient) QueryParamsResponse(ctx context.Context) (sdk.Response, error) {
+	conn, err := tc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewQueryClient(conn).Params(ctx, &QueryParamsRequest{})
+}
@@ -0,0 +1,106 @@
+package transfer
+
+import (
+	"errors"
+	"strings"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	ModuleName = "transfer"
+
+	// PortID is the port of the transfer module
+	PortID = "transfer"
+
+	TypeMsgTransfer = "transfer"
+)
+
+var _ sdk.Msg = &MsgTransfer{}
+
+// Traces is a list of the denom traces
+type Traces []DenomTrace
+
+// NewHeight returns the height of the revision
+func NewHeight(revisionNumber, revisionHeight uint64) Height {
+	return Height{
+		RevisionNumber: revisionNumber,
+		RevisionHeight: revisionHeight,
+	}
+}
+
+// IsZero returns true if the timeout height is disabled
+func (h Height) IsZero() bool {
+	return h.RevisionNumber == 0 && h.RevisionHeight == 0
+}
+
+func (msg MsgTransfer) Route() string { return ModuleName }
+
+func (msg MsgTransfer) Type() string { return TypeMsgTransfer }
+
+func (msg MsgTransfer) ValidateBasic() error {
+	if len(strings.TrimSpace(msg.SourcePort)) == 0 {
+		return errors.New("source port can not be empty")
+	}
+	if len(strings.TrimSpace(msg.SourceChannel)) == 0 {
+		return errors.New("source channel can not be empty")
+	}
+	if !msg.Token.IsValid() || !msg.Token.IsPositive() {
+		return errors.New("invalid token")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.New("invalid sender address")
+	}
+	if len(strings.TrimSpace(msg.Receiver)) == 0 {
+		return errors.New("receiver can not be empty")
+	}
+	if msg.TimeoutHeight.IsZero() && msg.TimeoutTimestamp == 0 {
+		return errors.New("timeout height and timeout timestamp can not both be zero")
+	}
+	return nil
+}
+
+func (msg MsgTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgTransfer) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (dt DenomTrace) Convert() interface{} {
+	return sdk.DenomTrace{
+		Path:      dt.Path,
+		BaseDenom: dt.BaseDenom,
+	}
+}
+
+func (q QueryDenomTracesResponse) Convert() interface{} {
+	traces := make([]sdk.DenomTrace, 0, len(q.DenomTraces))
+	for _, trace := range q.DenomTraces {
+		traces = append(traces, trace.Convert().(sdk.DenomTrace))
+	}
+
+	var total uint64
+	if q.Pagination != nil {
+		total = q.Pagination.Total
+	}
+	return QueryDenomTracesResp{
+		DenomTraces: traces,
+		Total:       total,
+	}
+}
+
+func (q QueryParamsResponse) Convert() interface{} {
+	if q.Params == nil {
+		return QueryParamsResp{}
+	}
+	return QueryParamsResp{
+		SendEnabled:    q.Params.SendEnabled,
+		ReceiveEnabled: q.Params.ReceiveEnabled,
+	}
+}
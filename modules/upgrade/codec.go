@@ -0,0 +1,14 @@
+package upgrade
+
+import (
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+	"github.com/irisnet/irishub-sdk-go/modules/gov"
+)
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*gov.Content)(nil),
+		&SoftwareUpgradeProposal{},
+		&CancelSoftwareUpgradeProposal{},
+	)
+}
@@ -0,0 +1,55 @@
+package upgrade
+
+import (
+	"time"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// expose upgrade module api for user
+type Client interface {
+	sdk.Module
+
+	// QueryCurrentPlan returns the plan of the next upgrade, the name of the plan is empty if no upgrade is planned
+	QueryCurrentPlan() (QueryPlanResp, sdk.Error)
+	// QueryAppliedPlan returns the height at which the plan was applied, 0 if the plan is not applied
+	QueryAppliedPlan(name string) (int64, sdk.Error)
+	// QueryUpgradedConsensusState returns the consensus state of the upgraded chain, which is stored
+	// at the last height of the chain before the upgrade
+	QueryUpgradedConsensusState(lastHeight int64) (QueryUpgradedConsensusStateResp, sdk.Error)
+
+	// WatchUpgrade polls the latest height and the current plan until the watcher is stopped
+	WatchUpgrade(request WatchUpgradeRequest) (*Watcher, sdk.Error)
+}
+
+type QueryPlanResp struct {
+	Name string `json:"name"`
+	// Time is the time of the upgrade if the plan is scheduled by time instead of by height
+	Time   time.Time `json:"time"`
+	Height int64     `json:"height"`
+	Info   string    `json:"info"`
+}
+
+// QueryUpgradedConsensusStateResp is the protobuf Any of the consensus state, e.g. the
+// tendermint consensus state of ibc
+type QueryUpgradedConsensusStateResp struct {
+	TypeUrl string `json:"type_url"`
+	Value   []byte `json:"value"`
+}
+
+// UpgradeCallback is called with the plan of the upgrade and the latest height of the chain
+type UpgradeCallback func(plan QueryPlanResp, height int64)
+
+type WatchUpgradeRequest struct {
+	// BlocksBefore is the number of blocks before the upgrade height when OnApproaching is called
+	BlocksBefore int64
+	// OnApproaching is called once when the chain reaches BlocksBefore blocks before the upgrade height
+	OnApproaching UpgradeCallback
+	// OnHalt is called once when the chain stops producing blocks at the upgrade height,
+	// the last block of the chain is the one before the upgrade height
+	OnHalt UpgradeCallback
+	// Interval is the interval of the polling, DefaultWatchInterval if 0
+	Interval time.Duration
+	// HaltTimeout is the duration without a new block after which the chain is halted, DefaultHaltTimeout if 0
+	HaltTimeout time.Duration
+}
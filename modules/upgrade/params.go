@@ -0,0 +1,23 @@
+package upgrade
+
+import (
+	yaml "gopkg.in/yaml.v2"
+)
+
+// String implements the Stringer interface.
+func (p Plan) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// String implements the Stringer interface.
+func (sup SoftwareUpgradeProposal) String() string {
+	out, _ := yaml.Marshal(sup)
+	return string(out)
+}
+
+// String implements the Stringer interface.
+func (csup CancelSoftwareUpgradeProposal) String() string {
+	out, _ := yaml.Marshal(csup)
+	return string(out)
+}
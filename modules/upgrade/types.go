@@ -0,0 +1,96 @@
+package upgrade
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/irisnet/irishub-sdk-go/modules/gov"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	ModuleName = "upgrade"
+
+	ProposalTypeSoftwareUpgrade       = "SoftwareUpgrade"
+	ProposalTypeCancelSoftwareUpgrade = "CancelSoftwareUpgrade"
+)
+
+var (
+	_ gov.Content = &SoftwareUpgradeProposal{}
+	_ gov.Content = &CancelSoftwareUpgradeProposal{}
+)
+
+func init() {
+	gov.RegisterProposalType(ProposalTypeSoftwareUpgrade)
+	gov.RegisterProposalType(ProposalTypeCancelSoftwareUpgrade)
+}
+
+// ValidateBasic does basic validation of a Plan
+func (p Plan) ValidateBasic() error {
+	if len(p.Name) == 0 {
+		return sdk.Wrapf("name cannot be empty")
+	}
+	if p.Height < 0 {
+		return sdk.Wrapf("height cannot be negative")
+	}
+
+	isValidTime := p.Time.Unix() > 0
+	if !isValidTime && p.Height == 0 {
+		return sdk.Wrapf("must set either time or height")
+	}
+	if isValidTime && p.Height != 0 {
+		return sdk.Wrapf("cannot set both time and height")
+	}
+	if isValidTime && p.UpgradedClientState != nil {
+		return sdk.Wrapf("IBC chain upgrades must only set height")
+	}
+	return nil
+}
+
+// DueAt is a string representation of when this plan is due to be executed
+func (p Plan) DueAt() string {
+	if p.Time.Unix() > 0 {
+		return fmt.Sprintf("time: %s", p.Time.UTC().Format(time.RFC3339))
+	}
+	return fmt.Sprintf("height: %d", p.Height)
+}
+
+// NewSoftwareUpgradeProposal creates a proposal to upgrade the software by the plan
+func NewSoftwareUpgradeProposal(title, description string, plan Plan) *SoftwareUpgradeProposal {
+	return &SoftwareUpgradeProposal{title, description, plan}
+}
+
+func (sup *SoftwareUpgradeProposal) GetTitle() string       { return sup.Title }
+func (sup *SoftwareUpgradeProposal) GetDescription() string { return sup.Description }
+func (sup *SoftwareUpgradeProposal) ProposalRoute() string  { return ModuleName }
+func (sup *SoftwareUpgradeProposal) ProposalType() string   { return ProposalTypeSoftwareUpgrade }
+func (sup *SoftwareUpgradeProposal) ValidateBasic() error {
+	if err := sup.Plan.ValidateBasic(); err != nil {
+		return err
+	}
+	return gov.ValidateAbstract(sup)
+}
+
+// NewCancelSoftwareUpgradeProposal creates a proposal to cancel the current upgrade plan
+func NewCancelSoftwareUpgradeProposal(title, description string) *CancelSoftwareUpgradeProposal {
+	return &CancelSoftwareUpgradeProposal{title, description}
+}
+
+func (csup *CancelSoftwareUpgradeProposal) GetTitle() string       { return csup.Title }
+func (csup *CancelSoftwareUpgradeProposal) GetDescription() string { return csup.Description }
+func (csup *CancelSoftwareUpgradeProposal) ProposalRoute() string  { return ModuleName }
+func (csup *CancelSoftwareUpgradeProposal) ProposalType() string {
+	return ProposalTypeCancelSoftwareUpgrade
+}
+func (csup *CancelSoftwareUpgradeProposal) ValidateBasic() error {
+	return gov.ValidateAbstract(csup)
+}
+
+func (p Plan) Convert() interface{} {
+	return QueryPlanResp{
+		Name:   p.Name,
+		Time:   p.Time,
+		Height: p.Height,
+		Info:   p.Info,
+	}
+}
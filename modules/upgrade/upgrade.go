@@ -0,0 +1,108 @@
+package upgrade
+
+import (
+	"context"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+type upgradeClient struct {
+	sdk.BaseClient
+	codec.Marshaler
+}
+
+func NewClient(baseClient sdk.BaseClient, marshaler codec.Marshaler) Client {
+	return &upgradeClient{
+		BaseClient: baseClient,
+		Marshaler:  marshaler,
+	}
+}
+
+func (uc upgradeClient) Name() string {
+	return ModuleName
+}
+
+func (uc upgradeClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
+	RegisterInterfaces(registry)
+}
+
+func (uc upgradeClient) QueryCurrentPlan() (QueryPlanResp, sdk.Error) {
+	conn, err := uc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return QueryPlanResp{}, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).CurrentPlan(
+		context.Background(),
+		&QueryCurrentPlanRequest{},
+	)
+	if err != nil {
+		return QueryPlanResp{}, sdk.Wrap(err)
+	}
+
+	if res.Plan == nil {
+		return QueryPlanResp{}, nil
+	}
+	return res.Plan.Convert().(QueryPlanResp), nil
+}
+
+func (uc upgradeClient) QueryAppliedPlan(name string) (int64, sdk.Error) {
+	conn, err := uc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return 0, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).AppliedPlan(
+		context.Background(),
+		&QueryAppliedPlanRequest{
+			Name: name,
+		},
+	)
+	if err != nil {
+		return 0, sdk.Wrap(err)
+	}
+	return res.Height, nil
+}
+
+func (uc upgradeClient) QueryUpgradedConsensusState(lastHeight int64) (QueryUpgradedConsensusStateResp, sdk.Error) {
+	conn, err := uc.GenConn()
+	defer func() { _ = conn.Close() }()
+	if err != nil {
+		return QueryUpgradedConsensusStateResp{}, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).UpgradedConsensusState(
+		context.Background(),
+		&QueryUpgradedConsensusStateRequest{
+			LastHeight: lastHeight,
+		},
+	)
+	if err != nil {
+		return QueryUpgradedConsensusStateResp{}, sdk.Wrap(err)
+	}
+
+	if res.UpgradedConsensusState == nil {
+		return QueryUpgradedConsensusStateResp{}, sdk.Wrapf("no upgraded consensus state at height %d", lastHeight)
+	}
+	return QueryUpgradedConsensusStateResp{
+		TypeUrl: res.UpgradedConsensusState.TypeUrl,
+		Value:   res.UpgradedConsensusState.Value,
+	}, nil
+}
+
+func (uc upgradeClient) WatchUpgrade(request WatchUpgradeRequest) (*Watcher, sdk.Error) {
+	if request.BlocksBefore < 0 {
+		return nil, sdk.Wrapf("blocks before the upgrade can not be negative")
+	}
+	if request.OnApproaching == nil && request.OnHalt == nil {
+		return nil, sdk.Wrapf("no callback of the upgrade")
+	}
+
+	w := newWatcher(uc, request)
+	go w.run()
+	return w, nil
+}
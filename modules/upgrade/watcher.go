@@ -0,0 +1,120 @@
+package upgrade
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultWatchInterval = 5 * time.Second
+	DefaultHaltTimeout   = time.Minute
+)
+
+// Watcher polls the latest height of the chain and the current plan, the chain panics at the
+// upgrade height of the plan, so the node stops producing blocks or stops responding there.
+// The plans scheduled by time instead of by height are not watched.
+type Watcher struct {
+	client  upgradeClient
+	request WatchUpgradeRequest
+
+	stop     chan struct{}
+	stopOnce sync.Once
+
+	plan       QueryPlanResp
+	approached bool
+	halted     bool
+	height     int64
+	// updated is the time when the height was seen for the first time
+	updated time.Time
+}
+
+func newWatcher(client upgradeClient, request WatchUpgradeRequest) *Watcher {
+	if request.Interval <= 0 {
+		request.Interval = DefaultWatchInterval
+	}
+	if request.HaltTimeout <= 0 {
+		request.HaltTimeout = DefaultHaltTimeout
+	}
+
+	return &Watcher{
+		client:  client,
+		request: request,
+		stop:    make(chan struct{}),
+	}
+}
+
+// Stop stops the polling, the callbacks are not called after Stop returns unless one is running
+func (w *Watcher) Stop() {
+	w.stopOnce.Do(func() { close(w.stop) })
+}
+
+func (w *Watcher) run() {
+	ticker := time.NewTicker(w.request.Interval)
+	defer ticker.Stop()
+
+	for {
+		w.poll()
+
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll queries the latest height and the current plan, the last ones are kept if the node
+// does not respond, e.g. it exits at the upgrade height
+func (w *Watcher) poll() {
+	plan, height := w.plan, w.height
+
+	status, err := w.client.Status(context.Background())
+	if err != nil {
+		w.client.Logger().Debug("failed to query the latest height", "errMsg", err.Error())
+	} else {
+		height = status.SyncInfo.LatestBlockHeight
+	}
+
+	if currentPlan, err := w.client.QueryCurrentPlan(); err != nil {
+		w.client.Logger().Debug("failed to query the current plan", "errMsg", err.Error())
+	} else {
+		plan = currentPlan
+	}
+
+	select {
+	case <-w.stop:
+		return
+	default:
+		w.update(plan, height, time.Now())
+	}
+}
+
+// update advances the watcher with the plan and the height observed at now
+func (w *Watcher) update(plan QueryPlanResp, height int64, now time.Time) {
+	// the plan is replaced or cancelled
+	if plan.Name != w.plan.Name || plan.Height != w.plan.Height {
+		w.plan, w.approached, w.halted = plan, false, false
+	}
+	if height > w.height {
+		w.height, w.updated = height, now
+	}
+
+	if len(w.plan.Name) == 0 || w.plan.Height <= 0 || w.height == 0 {
+		return
+	}
+
+	if !w.approached && w.height >= w.plan.Height-w.request.BlocksBefore {
+		w.approached = true
+		if w.request.OnApproaching != nil {
+			w.request.OnApproaching(w.plan, w.height)
+		}
+	}
+
+	if !w.halted && w.height >= w.plan.Height-1 && now.Sub(w.updated) >= w.request.HaltTimeout {
+		w.halted = true
+		if w.request.OnHalt != nil {
+			w.request.OnHalt(w.plan, w.height)
+		}
+	}
+}
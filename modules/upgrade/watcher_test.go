@@ -0,0 +1,61 @@
+package upgrade
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatcher(t *testing.T) {
+	var approached, halted []int64
+	w := newWatcher(upgradeClient{}, WatchUpgradeRequest{
+		BlocksBefore:  10,
+		OnApproaching: func(_ QueryPlanResp, height int64) { approached = append(approached, height) },
+		OnHalt:        func(_ QueryPlanResp, height int64) { halted = append(halted, height) },
+	})
+
+	plan := QueryPlanResp{Name: "v2", Height: 100}
+	now := time.Now()
+
+	w.update(QueryPlanResp{}, 80, now)
+	w.update(plan, 89, now)
+	require.Empty(t, approached)
+
+	w.update(plan, 90, now)
+	w.update(plan, 91, now)
+	require.Equal(t, []int64{90}, approached)
+
+	// the chain stops at the block before the upgrade height
+	w.update(plan, 99, now)
+	w.update(plan, 99, now.Add(DefaultHaltTimeout/2))
+	require.Empty(t, halted)
+
+	w.update(plan, 99, now.Add(DefaultHaltTimeout))
+	w.update(plan, 99, now.Add(2*DefaultHaltTimeout))
+	require.Equal(t, []int64{99}, halted)
+
+	// a new plan is watched again
+	plan = QueryPlanResp{Name: "v3", Height: 200}
+	w.update(plan, 195, now.Add(3*DefaultHaltTimeout))
+	require.Equal(t, []int64{90, 195}, approached)
+	require.Equal(t, []int64{99}, halted)
+}
+
+func TestWatcherCancelledPlan(t *testing.T) {
+	var approached int
+	w := newWatcher(upgradeClient{}, WatchUpgradeRequest{
+		BlocksBefore:  10,
+		OnApproaching: func(QueryPlanResp, int64) { approached++ },
+	})
+
+	now := time.Now()
+	w.update(QueryPlanResp{Name: "v2", Height: 100}, 95, now)
+	w.update(QueryPlanResp{}, 96, now)
+	w.update(QueryPlanResp{}, 99, now.Add(2*DefaultHaltTimeout))
+	require.Equal(t, 1, approached)
+
+	// the plans scheduled by time are not watched
+	w.update(QueryPlanResp{Name: "v2", Time: now}, 100, now)
+	require.Equal(t, 1, approached)
+}
@@ -0,0 +1,102 @@
+package sdk
+
+import (
+	"context"
+	"reflect"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/irisnet/irishub-sdk-go/types"
+)
+
+var _ types.ParamQuery = (*IRISHUBClient)(nil)
+
+// QueryParams queries the params of the registered module, res must be a pointer to the
+// grpc response of the params query of the module, e.g. *bank.QueryParamsResponse
+func (client *IRISHUBClient) QueryParams(module string, res types.Response) types.Error {
+	params, err := client.queryParams(context.Background(), module)
+	if err != nil {
+		return types.Wrap(err)
+	}
+
+	dst, src := reflect.ValueOf(res), reflect.ValueOf(params)
+	if dst.Kind() != reflect.Ptr || dst.IsNil() || dst.Type() != src.Type() {
+		return types.Wrapf("the params response of %s is %T, got %T", module, params, res)
+	}
+	dst.Elem().Set(src.Elem())
+	return nil
+}
+
+// QueryChainParams returns the params of all registered modules at the height, 0 for the latest height.
+// The modules not served by the node are skipped.
+func (client *IRISHUBClient) QueryChainParams(height int64) (types.ChainParams, types.Error) {
+	if height <= 0 {
+		res, err := client.Status(context.Background())
+		if err != nil {
+			return types.ChainParams{}, types.Wrap(err)
+		}
+		height = res.SyncInfo.LatestBlockHeight
+	}
+
+	names := make([]string, 0, len(client.moduleManager))
+	for name := range client.moduleManager {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	ctx := types.ContextWithHeight(context.Background(), height)
+	chainParams := types.ChainParams{
+		Height: height,
+		Params: make(map[string]interface{}),
+	}
+	for _, name := range names {
+		if _, ok := client.moduleManager[name].(types.ParamsModule); !ok {
+			continue
+		}
+
+		params, err := client.queryParams(ctx, name)
+		if status.Code(err) == codes.Unimplemented {
+			client.logger.Debug("params are not served by the node", "module", name)
+			continue
+		}
+		if err != nil {
+			return types.ChainParams{}, types.WrapWithMessage(err, "failed to query the params of %s", name)
+		}
+		chainParams.Params[name] = params.Convert()
+	}
+	return chainParams, nil
+}
+
+// DiffChainParams returns the params changed between the heights, e.g. by the parameter change proposals
+func (client *IRISHUBClient) DiffChainParams(fromHeight, toHeight int64) ([]types.ParamChange, types.Error) {
+	from, err := client.QueryChainParams(fromHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := client.QueryChainParams(toHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, e := types.DiffChainParams(from, to)
+	if e != nil {
+		return nil, types.Wrap(e)
+	}
+	return changes, nil
+}
+
+func (client *IRISHUBClient) queryParams(ctx context.Context, module string) (types.Response, error) {
+	m, ok := client.moduleManager[module]
+	if !ok {
+		return nil, types.Wrapf("module %s is not registered", module)
+	}
+
+	paramsModule, ok := m.(types.ParamsModule)
+	if !ok {
+		return nil, types.Wrapf("module %s has no params", module)
+	}
+	return paramsModule.QueryParamsResponse(ctx)
+}
@@ -0,0 +1,60 @@
+package recorder
+
+import (
+	"github.com/irisnet/irishub-sdk-go/codec"
+	cdctypes "github.com/irisnet/irishub-sdk-go/codec/types"
+	cryptocodec "github.com/irisnet/irishub-sdk-go/crypto/codec"
+	"github.com/irisnet/irishub-sdk-go/modules/auth"
+	"github.com/irisnet/irishub-sdk-go/modules/bank"
+	"github.com/irisnet/irishub-sdk-go/modules/coinswap"
+	"github.com/irisnet/irishub-sdk-go/modules/distribution"
+	"github.com/irisnet/irishub-sdk-go/modules/gov"
+	"github.com/irisnet/irishub-sdk-go/modules/htlc"
+	"github.com/irisnet/irishub-sdk-go/modules/mint"
+	"github.com/irisnet/irishub-sdk-go/modules/nft"
+	"github.com/irisnet/irishub-sdk-go/modules/oracle"
+	"github.com/irisnet/irishub-sdk-go/modules/params"
+	"github.com/irisnet/irishub-sdk-go/modules/random"
+	"github.com/irisnet/irishub-sdk-go/modules/record"
+	"github.com/irisnet/irishub-sdk-go/modules/service"
+	"github.com/irisnet/irishub-sdk-go/modules/slashing"
+	"github.com/irisnet/irishub-sdk-go/modules/staking"
+	"github.com/irisnet/irishub-sdk-go/modules/token"
+	"github.com/irisnet/irishub-sdk-go/modules/transfer"
+	"github.com/irisnet/irishub-sdk-go/modules/upgrade"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	txtypes "github.com/irisnet/irishub-sdk-go/types/tx"
+)
+
+// txConfig encodes the transactions of the recorded events, it knows the messages of all modules
+var txConfig = makeTxConfig()
+
+func makeTxConfig() sdk.TxConfig {
+	registry := cdctypes.NewInterfaceRegistry()
+	registry.RegisterInterface("cosmos.v1beta1.Msg", (*sdk.Msg)(nil))
+	txtypes.RegisterInterfaces(registry)
+	cryptocodec.RegisterInterfaces(registry)
+	for _, registerInterfaces := range []func(cdctypes.InterfaceRegistry){
+		auth.RegisterInterfaces,
+		bank.RegisterInterfaces,
+		token.RegisterInterfaces,
+		transfer.RegisterInterfaces,
+		coinswap.RegisterInterfaces,
+		distribution.RegisterInterfaces,
+		gov.RegisterInterfaces,
+		htlc.RegisterInterfaces,
+		mint.RegisterInterfaces,
+		nft.RegisterInterfaces,
+		oracle.RegisterInterfaces,
+		params.RegisterInterfaces,
+		random.RegisterInterfaces,
+		record.RegisterInterfaces,
+		service.RegisterInterfaces,
+		slashing.RegisterInterfaces,
+		staking.RegisterInterfaces,
+		upgrade.RegisterInterfaces,
+	} {
+		registerInterfaces(registry)
+	}
+	return txtypes.NewTxConfig(codec.NewProtoCodec(registry), txtypes.DefaultSignModes)
+}
@@ -0,0 +1,108 @@
+package recorder
+
+import (
+	"encoding/json"
+
+	tmjson "github.com/tendermint/tendermint/libs/json"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// eventMethod is the method of the recorded events, their request identifies the subscription
+const eventMethod = "event"
+
+type eventRequest struct {
+	ID string `json:"id"`
+}
+
+type jsonEncoding struct{}
+
+func (jsonEncoding) Marshal(o interface{}) ([]byte, error) {
+	return json.Marshal(o)
+}
+
+func (jsonEncoding) Unmarshal(bz []byte, ptr interface{}) error {
+	return json.Unmarshal(bz, ptr)
+}
+
+// eventCodec converts the events of a subscription from and to JSON
+type eventCodec struct {
+	encode func(data sdk.EventData) ([]byte, error)
+	decode func(bz []byte) (sdk.EventData, error)
+}
+
+// txEvent is a recorded sdk.EventDataTx, its transaction is kept encoded
+type txEvent struct {
+	Hash   string       `json:"hash"`
+	Height int64        `json:"height"`
+	Index  uint32       `json:"index"`
+	Tx     []byte       `json:"tx"`
+	Result sdk.TxResult `json:"result"`
+}
+
+func marshalEvent(data sdk.EventData) ([]byte, error) {
+	return tmjson.Marshal(data)
+}
+
+var (
+	txEvents = eventCodec{
+		encode: func(data sdk.EventData) ([]byte, error) {
+			dataTx := data.(sdk.EventDataTx)
+			tx, err := txConfig.TxEncoder()(dataTx.Tx)
+			if err != nil {
+				return nil, err
+			}
+			return json.Marshal(txEvent{
+				Hash:   dataTx.Hash,
+				Height: dataTx.Height,
+				Index:  dataTx.Index,
+				Tx:     tx,
+				Result: dataTx.Result,
+			})
+		},
+		decode: func(bz []byte) (sdk.EventData, error) {
+			var event txEvent
+			if err := json.Unmarshal(bz, &event); err != nil {
+				return nil, err
+			}
+			tx, err := txConfig.TxDecoder()(event.Tx)
+			if err != nil {
+				return nil, err
+			}
+			return sdk.EventDataTx{
+				Hash:   event.Hash,
+				Height: event.Height,
+				Index:  event.Index,
+				Tx:     tx,
+				Result: event.Result,
+			}, nil
+		},
+	}
+
+	newBlockEvents = eventCodec{
+		encode: marshalEvent,
+		decode: func(bz []byte) (sdk.EventData, error) {
+			var data sdk.EventDataNewBlock
+			err := tmjson.Unmarshal(bz, &data)
+			return data, err
+		},
+	}
+
+	newBlockHeaderEvents = eventCodec{
+		encode: marshalEvent,
+		decode: func(bz []byte) (sdk.EventData, error) {
+			var data sdk.EventDataNewBlockHeader
+			err := tmjson.Unmarshal(bz, &data)
+			return data, err
+		},
+	}
+
+	validatorSetUpdatesEvents = eventCodec{
+		encode: marshalEvent,
+		decode: func(bz []byte) (sdk.EventData, error) {
+			var data sdk.EventDataValidatorSetUpdates
+			err := tmjson.Unmarshal(bz, &data)
+			return data, err
+		},
+	}
+)
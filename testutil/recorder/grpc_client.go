@@ -0,0 +1,94 @@
+package recorder
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+
+	cdctypes "github.com/irisnet/irishub-sdk-go/codec/types"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+var _ sdk.QueryConn = queryConn{}
+
+type protoEncoding struct{}
+
+func (protoEncoding) Marshal(o interface{}) ([]byte, error) {
+	msg, ok := o.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a proto message", o)
+	}
+
+	// unlike codec.ProtoMarshalJSON the defaults are omitted, an empty list is replayed as nil
+	// like the grpc codec decodes it
+	jm := &jsonpb.Marshaler{OrigName: true}
+	if err := cdctypes.UnpackInterfaces(msg, cdctypes.ProtoJSONPacker{JSONPBMarshaler: jm}); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	if err := jm.Marshal(buf, msg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (protoEncoding) Unmarshal(bz []byte, ptr interface{}) error {
+	msg, ok := ptr.(proto.Message)
+	if !ok {
+		return fmt.Errorf("%T is not a proto message", ptr)
+	}
+	return jsonpb.Unmarshal(bytes.NewReader(bz), msg)
+}
+
+// grpcClient records the unary calls of the connections of a grpc client
+type grpcClient struct {
+	client sdk.GRPCClient
+	r      *Recorder
+}
+
+// NewGRPCClient returns a grpc client served by the recorder, client may be nil when replaying
+func NewGRPCClient(client sdk.GRPCClient, r *Recorder) sdk.GRPCClient {
+	return grpcClient{client: client, r: r}
+}
+
+func (g grpcClient) GenConn() (sdk.QueryConn, error) {
+	if g.r.mode == Replay {
+		return queryConn{r: g.r}, nil
+	}
+
+	conn, err := g.client.GenConn()
+	if err != nil {
+		return nil, err
+	}
+	return queryConn{conn: conn, r: g.r}, nil
+}
+
+type queryConn struct {
+	conn sdk.QueryConn
+	r    *Recorder
+}
+
+func (q queryConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	return q.r.do(protoEncoding{}, method, args, reply, func() (interface{}, error) {
+		return reply, q.conn.Invoke(ctx, method, args, reply, opts...)
+	})
+}
+
+func (q queryConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if q.r.mode == Replay {
+		return nil, fmt.Errorf("stream %s can not be replayed", method)
+	}
+	return q.conn.NewStream(ctx, desc, method, opts...)
+}
+
+func (q queryConn) Close() error {
+	if q.conn == nil {
+		return nil
+	}
+	return q.conn.Close()
+}
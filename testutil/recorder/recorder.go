@@ -0,0 +1,409 @@
+// Package recorder captures the interactions of the sdk with a node into JSON fixtures
+// and replays them, so that tests talking to a node can run offline.
+//
+// An interaction is keyed by its method and its request. While replaying, the first
+// unused interaction with the same key is returned and a call whose request is not
+// recorded fails; a recorder which is not strict returns the next unused interaction of
+// the same method instead.
+//
+// The events received by a subscription are recorded as interactions too, they are
+// replayed once every interaction recorded before them has been replayed.
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Record performs the calls and records their results
+	Record Mode = "record"
+	// Replay returns the recorded results without performing the calls
+	Replay Mode = "replay"
+	// Passthrough performs the calls without recording them
+	Passthrough Mode = "passthrough"
+
+	// eventTimeout bounds the wait of a replayed event for the interactions recorded before it
+	eventTimeout = 10 * time.Second
+)
+
+// Mode defines how the calls are served by the recorder
+type Mode string
+
+// Interaction is a recorded call
+type Interaction struct {
+	Method   string          `json:"method"`
+	Request  json.RawMessage `json:"request"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Error    *Error          `json:"error,omitempty"`
+}
+
+// Error is the recorded error of a call, the grpc code is only set by grpc calls
+type Error struct {
+	GRPCCode uint32 `json:"grpc_code,omitempty"`
+	Message  string `json:"message"`
+}
+
+func newError(err error) *Error {
+	if s, ok := status.FromError(err); ok && s.Code() != codes.OK && s.Code() != codes.Unknown {
+		return &Error{GRPCCode: uint32(s.Code()), Message: s.Message()}
+	}
+	return &Error{Message: err.Error()}
+}
+
+func (e Error) err() error {
+	if e.GRPCCode != 0 {
+		return status.Error(codes.Code(e.GRPCCode), e.Message)
+	}
+	return errors.New(e.Message)
+}
+
+type fixture struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// encoding converts the requests and the responses of a transport from and to JSON
+type encoding interface {
+	Marshal(o interface{}) ([]byte, error)
+	Unmarshal(bz []byte, ptr interface{}) error
+}
+
+// Recorder records or replays the interactions stored in a fixture file
+type Recorder struct {
+	mtx          sync.Mutex
+	mode         Mode
+	path         string
+	strict       bool
+	interactions []*Interaction
+	used         []bool
+	// progress is closed and renewed whenever an interaction is replayed
+	progress chan struct{}
+	// subscriptions stops the replay of the events of each subscription
+	subscriptions map[string]chan struct{}
+}
+
+// New returns a strict recorder of the fixture file at path, the file is loaded when replaying.
+// path may be empty when the fixture file is opened later
+func New(path string, mode Mode) (*Recorder, error) {
+	switch mode {
+	case Record, Replay, Passthrough:
+	default:
+		return nil, fmt.Errorf("mode %s is not supported", mode)
+	}
+
+	r := &Recorder{
+		mode:          mode,
+		strict:        true,
+		progress:      make(chan struct{}),
+		subscriptions: make(map[string]chan struct{}),
+	}
+	if err := r.load(path); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Open saves the interactions recorded so far and switches the recorder to the fixture
+// file at path, the events of the subscriptions replayed from the previous file are dropped
+func (r *Recorder) Open(path string) error {
+	if err := r.Save(); err != nil {
+		return err
+	}
+
+	r.mtx.Lock()
+	for id, stop := range r.subscriptions {
+		close(stop)
+		delete(r.subscriptions, id)
+	}
+	r.mtx.Unlock()
+	return r.load(path)
+}
+
+func (r *Recorder) load(path string) error {
+	var f fixture
+	if r.mode == Replay && len(path) > 0 {
+		bz, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(bz, &f); err != nil {
+			return fmt.Errorf("invalid fixture %s: %s", path, err.Error())
+		}
+		for _, in := range f.Interactions {
+			if in.Request, err = compact(in.Request); err != nil {
+				return err
+			}
+		}
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.path = path
+	r.interactions = f.Interactions
+	r.used = make([]bool, len(f.Interactions))
+	return nil
+}
+
+// Mode returns the mode of the recorder
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// SetStrict only replays the interactions whose request matches the call when enabled, which is the default
+func (r *Recorder) SetStrict(strict bool) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.strict = strict
+}
+
+// Save writes the recorded interactions into the fixture file, it does nothing unless recording
+func (r *Recorder) Save() error {
+	if r.mode != Record {
+		return nil
+	}
+
+	r.mtx.Lock()
+	path := r.path
+	bz, err := json.MarshalIndent(fixture{Interactions: r.interactions}, "", "  ")
+	r.mtx.Unlock()
+	if err != nil || len(path) == 0 {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, bz, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// do serves the call `method` according to the mode of the recorder, exec performs the call
+// and returns its result which is copied into response
+func (r *Recorder) do(enc encoding, method string, request, response interface{}, exec func() (interface{}, error)) error {
+	if r.mode == Passthrough {
+		res, err := exec()
+		if err != nil {
+			return err
+		}
+		return assign(response, res)
+	}
+
+	reqBz, err := enc.Marshal(request)
+	if err != nil {
+		return err
+	}
+	if reqBz, err = compact(reqBz); err != nil {
+		return err
+	}
+
+	if r.mode == Replay {
+		in, err := r.find(method, reqBz)
+		if err != nil {
+			return err
+		}
+		if in.Error != nil {
+			return in.Error.err()
+		}
+		return enc.Unmarshal(in.Response, response)
+	}
+
+	in := &Interaction{Method: method, Request: reqBz}
+	res, callErr := exec()
+	if callErr != nil {
+		in.Error = newError(callErr)
+	} else {
+		if in.Response, err = enc.Marshal(res); err != nil {
+			return err
+		}
+		if err := assign(response, res); err != nil {
+			return err
+		}
+	}
+
+	r.append(in)
+	return callErr
+}
+
+func (r *Recorder) append(in *Interaction) {
+	r.mtx.Lock()
+	r.interactions = append(r.interactions, in)
+	r.mtx.Unlock()
+}
+
+// recordEvent records an event received by the subscription id
+func (r *Recorder) recordEvent(id string, event []byte) error {
+	reqBz, err := json.Marshal(eventRequest{ID: id})
+	if err != nil {
+		return err
+	}
+	if event, err = compact(event); err != nil {
+		return err
+	}
+
+	r.append(&Interaction{Method: eventMethod, Request: reqBz, Response: event})
+	return nil
+}
+
+// replayEvents delivers the events recorded for the subscription id in their recorded order,
+// each one once the interactions recorded before it are replayed or after eventTimeout
+func (r *Recorder) replayEvents(id string, deliver func(event []byte)) {
+	reqBz, _ := json.Marshal(eventRequest{ID: id})
+	stop := make(chan struct{})
+
+	r.mtx.Lock()
+	r.subscriptions[id] = stop
+	var events []int
+	for i, in := range r.interactions {
+		if in.Method == eventMethod && bytes.Equal(in.Request, reqBz) {
+			events = append(events, i)
+		}
+	}
+	r.mtx.Unlock()
+
+	go func() {
+		for _, i := range events {
+			if !r.waitReplayed(i, stop) {
+				return
+			}
+
+			r.mtx.Lock()
+			select {
+			case <-stop:
+				r.mtx.Unlock()
+				return
+			default:
+			}
+			r.use(i)
+			event := r.interactions[i].Response
+			r.mtx.Unlock()
+			go deliver(event)
+		}
+	}()
+}
+
+// waitReplayed waits for the interactions recorded before the i-th one to be replayed,
+// it returns false when the subscription is stopped
+func (r *Recorder) waitReplayed(i int, stop <-chan struct{}) bool {
+	timeout := time.After(eventTimeout)
+	for {
+		r.mtx.Lock()
+		select {
+		case <-stop:
+			r.mtx.Unlock()
+			return false
+		default:
+		}
+		ready, progress := r.replayed(i), r.progress
+		r.mtx.Unlock()
+		if ready {
+			return true
+		}
+
+		select {
+		case <-progress:
+		case <-stop:
+			return false
+		case <-timeout:
+			return true
+		}
+	}
+}
+
+// stopEvents stops the replay of the events of the subscription id, its pending events are dropped
+func (r *Recorder) stopEvents(id string) {
+	reqBz, _ := json.Marshal(eventRequest{ID: id})
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if stop, ok := r.subscriptions[id]; ok {
+		close(stop)
+		delete(r.subscriptions, id)
+	}
+	for i, in := range r.interactions {
+		if !r.used[i] && in.Method == eventMethod && bytes.Equal(in.Request, reqBz) {
+			r.use(i)
+		}
+	}
+}
+
+// replayed reports whether the interactions recorded before the i-th one are replayed
+func (r *Recorder) replayed(i int) bool {
+	for _, used := range r.used[:i] {
+		if !used {
+			return false
+		}
+	}
+	return true
+}
+
+// use marks the i-th interaction as replayed and wakes up the events waiting for it
+func (r *Recorder) use(i int) {
+	r.used[i] = true
+	close(r.progress)
+	r.progress = make(chan struct{})
+}
+
+// find returns the first unused interaction matching the call
+func (r *Recorder) find(method string, request []byte) (*Interaction, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	fallback := -1
+	for i, in := range r.interactions {
+		if r.used[i] || in.Method != method {
+			continue
+		}
+		if bytes.Equal(in.Request, request) {
+			r.use(i)
+			return in, nil
+		}
+		if fallback < 0 {
+			fallback = i
+		}
+	}
+
+	if fallback < 0 || r.strict {
+		return nil, fmt.Errorf("no recorded interaction for %s with request %s", method, request)
+	}
+	r.use(fallback)
+	return r.interactions[fallback], nil
+}
+
+// assign copies the result of a call into the response, both are pointers of the same type
+func assign(response, res interface{}) error {
+	dst, src := reflect.ValueOf(response), reflect.ValueOf(res)
+	if dst.Kind() != reflect.Ptr || dst.Type() != src.Type() {
+		return fmt.Errorf("cannot assign %T to %T", res, response)
+	}
+	if dst.Pointer() != src.Pointer() && !src.IsNil() {
+		dst.Elem().Set(src.Elem())
+	}
+	return nil
+}
+
+func compact(bz []byte) ([]byte, error) {
+	if len(bz) == 0 {
+		return bz, nil
+	}
+
+	buf := new(bytes.Buffer)
+	if err := json.Compact(buf, bz); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,152 @@
+package recorder_test
+
+import (
+	"flag"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/irisnet/irishub-sdk-go"
+	"github.com/irisnet/irishub-sdk-go/modules/bank"
+	"github.com/irisnet/irishub-sdk-go/testutil/recorder"
+	"github.com/irisnet/irishub-sdk-go/testutil/simchain"
+	"github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/store"
+)
+
+const (
+	name     = "alice"
+	password = "12345678"
+	mnemonic = "nerve leader thank marriage spice task van start piece crowd run hospital control outside cousin romance left choice poet wagon rude climb leisure spring"
+	to       = "iaa1qzds87rxyrv4ak9gr2mx9ptjhsqs5thcha0e5n"
+)
+
+type result struct {
+	hash     string
+	sent     string
+	height   int64
+	sequence uint64
+	balances string
+	txHeight int64
+}
+
+func run(t *testing.T, client sdk.IRISHUBClient) result {
+	from, err := client.Key.Recover(name, password, mnemonic)
+	require.NoError(t, err)
+
+	amount, e := types.ParseDecCoins("10iris")
+	require.NoError(t, e)
+
+	sent := make(chan bank.EventDataMsgSend, 1)
+	subscription := client.Bank.SubscribeSendTx(from, to, func(data bank.EventDataMsgSend) {
+		sent <- data
+	})
+
+	res, err := client.Bank.Send(to, amount, types.BaseTx{From: name, Password: password})
+	require.NoError(t, err)
+
+	var event bank.EventDataMsgSend
+	select {
+	case event = <-sent:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "the send event is not received")
+	}
+	require.NoError(t, client.Unsubscribe(subscription))
+
+	account, err := client.Bank.QueryAccount(from)
+	require.NoError(t, err)
+
+	tx, e := client.QueryTx(res.Hash)
+	require.NoError(t, e)
+
+	return result{
+		hash:     res.Hash,
+		sent:     event.Hash,
+		height:   res.Height,
+		sequence: account.Sequence,
+		balances: account.Coins.String(),
+		txHeight: tx.Height,
+	}
+}
+
+// recordFixtures re-records the committed fixtures from the simchain
+var recordFixtures = flag.Bool("record", false, "record "+fixturesPath+" from the simchain instead of replaying it")
+
+const fixturesPath = "testdata/fixtures.json"
+
+// record runs the scenario against a simchain and records its interactions into the fixture file at path
+func record(t *testing.T, path string) (string, result) {
+	chain, err := simchain.New()
+	require.NoError(t, err)
+	defer func() { _ = chain.Close() }()
+
+	cfg, err := chain.ClientConfig(types.KeyDAOOption(store.NewMemory(nil)))
+	require.NoError(t, err)
+	node := sdk.NewIRISHUBClient(cfg)
+
+	from, err := node.Key.Recover(name, password, mnemonic)
+	require.NoError(t, err)
+	require.NoError(t, chain.Fund(from, types.NewInt64Coin("uiris", 100000000)))
+
+	rec, err := recorder.New(path, recorder.Record)
+	require.NoError(t, err)
+
+	cfg, err = chain.ClientConfig(
+		types.KeyDAOOption(store.NewMemory(nil)),
+		types.ModeOption(types.Commit),
+		types.TmClientOption(recorder.NewTmClient(node.BaseClient, rec)),
+		types.GRPCClientOption(recorder.NewGRPCClient(node.BaseClient, rec)),
+	)
+	require.NoError(t, err)
+	recorded := run(t, sdk.NewIRISHUBClient(cfg))
+	require.NoError(t, rec.Save())
+	return chain.ChainID(), recorded
+}
+
+// replay returns a client whose every call is served by the fixture file at path
+func replay(t *testing.T, path, chainID string) sdk.IRISHUBClient {
+	rec, err := recorder.New(path, recorder.Replay)
+	require.NoError(t, err)
+
+	cfg, err := types.NewClientConfig("tcp://127.0.0.1:1", "bufnet", chainID,
+		types.KeyDAOOption(store.NewMemory(nil)),
+		types.ModeOption(types.Commit),
+		types.TmClientOption(recorder.NewTmClient(nil, rec)),
+		types.GRPCClientOption(recorder.NewGRPCClient(nil, rec)),
+	)
+	require.NoError(t, err)
+	return sdk.NewIRISHUBClient(cfg)
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fixtures.json")
+	chainID, recorded := record(t, path)
+
+	// the chain is closed, every call is served by the fixtures
+	client := replay(t, path, chainID)
+	require.Equal(t, recorded, run(t, client))
+
+	// the interactions are consumed by the replay
+	_, err := client.Bank.QueryAccount(to)
+	require.Error(t, err)
+
+	_, err = client.SubscribeNewBlockHeader(func(types.EventDataNewBlockHeader) {})
+	require.Error(t, err)
+}
+
+// TestReplayFixtures runs the scenario offline from the committed fixtures,
+// run `go test -run TestReplayFixtures -record` to record them again
+func TestReplayFixtures(t *testing.T) {
+	if *recordFixtures {
+		record(t, fixturesPath)
+	}
+
+	res := run(t, replay(t, fixturesPath, "simchain"))
+	require.Equal(t, res.hash, res.sent)
+	require.Equal(t, int64(3), res.height)
+	require.Equal(t, res.height, res.txHeight)
+	require.Equal(t, uint64(1), res.sequence)
+	require.Equal(t, "86000000uiris", res.balances)
+}
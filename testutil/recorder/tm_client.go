@@ -0,0 +1,333 @@
+package recorder
+
+import (
+	"context"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+var _ sdk.TmClient = tmClient{}
+
+type tmEncoding struct{}
+
+func (tmEncoding) Marshal(o interface{}) ([]byte, error) {
+	return tmjson.Marshal(o)
+}
+
+func (tmEncoding) Unmarshal(bz []byte, ptr interface{}) error {
+	return tmjson.Unmarshal(bz, ptr)
+}
+
+// tmClient records the calls of the tendermint rpc client and the events of its subscriptions
+type tmClient struct {
+	client sdk.TmClient
+	r      *Recorder
+}
+
+// NewTmClient returns a tendermint rpc client served by the recorder, client may be nil when replaying
+func NewTmClient(client sdk.TmClient, r *Recorder) sdk.TmClient {
+	return tmClient{client: client, r: r}
+}
+
+func (c tmClient) ABCIInfo(ctx context.Context) (*ctypes.ResultABCIInfo, error) {
+	res := new(ctypes.ResultABCIInfo)
+	err := c.r.do(tmEncoding{}, "abci_info", struct{}{}, res, func() (interface{}, error) {
+		return c.client.ABCIInfo(ctx)
+	})
+	return res, err
+}
+
+func (c tmClient) ABCIQuery(ctx context.Context, path string, data tmbytes.HexBytes) (*ctypes.ResultABCIQuery, error) {
+	return c.ABCIQueryWithOptions(ctx, path, data, rpcclient.DefaultABCIQueryOptions)
+}
+
+func (c tmClient) ABCIQueryWithOptions(ctx context.Context, path string, data tmbytes.HexBytes,
+	opts rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	req := struct {
+		Path   string           `json:"path"`
+		Data   tmbytes.HexBytes `json:"data"`
+		Height int64            `json:"height"`
+		Prove  bool             `json:"prove"`
+	}{path, data, opts.Height, opts.Prove}
+
+	res := new(ctypes.ResultABCIQuery)
+	err := c.r.do(tmEncoding{}, "abci_query", req, res, func() (interface{}, error) {
+		return c.client.ABCIQueryWithOptions(ctx, path, data, opts)
+	})
+	return res, err
+}
+
+func (c tmClient) BroadcastTxCommit(ctx context.Context, tx tmtypes.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
+	res := new(ctypes.ResultBroadcastTxCommit)
+	err := c.r.do(tmEncoding{}, "broadcast_tx_commit", txRequest(tx), res, func() (interface{}, error) {
+		return c.client.BroadcastTxCommit(ctx, tx)
+	})
+	return res, err
+}
+
+func (c tmClient) BroadcastTxAsync(ctx context.Context, tx tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	res := new(ctypes.ResultBroadcastTx)
+	err := c.r.do(tmEncoding{}, "broadcast_tx_async", txRequest(tx), res, func() (interface{}, error) {
+		return c.client.BroadcastTxAsync(ctx, tx)
+	})
+	return res, err
+}
+
+func (c tmClient) BroadcastTxSync(ctx context.Context, tx tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	res := new(ctypes.ResultBroadcastTx)
+	err := c.r.do(tmEncoding{}, "broadcast_tx_sync", txRequest(tx), res, func() (interface{}, error) {
+		return c.client.BroadcastTxSync(ctx, tx)
+	})
+	return res, err
+}
+
+func (c tmClient) Block(ctx context.Context, height *int64) (*ctypes.ResultBlock, error) {
+	res := new(ctypes.ResultBlock)
+	err := c.r.do(tmEncoding{}, "block", heightRequest(height), res, func() (interface{}, error) {
+		return c.client.Block(ctx, height)
+	})
+	return res, err
+}
+
+func (c tmClient) BlockByHash(ctx context.Context, hash []byte) (*ctypes.ResultBlock, error) {
+	req := struct {
+		Hash tmbytes.HexBytes `json:"hash"`
+	}{hash}
+
+	res := new(ctypes.ResultBlock)
+	err := c.r.do(tmEncoding{}, "block_by_hash", req, res, func() (interface{}, error) {
+		return c.client.BlockByHash(ctx, hash)
+	})
+	return res, err
+}
+
+func (c tmClient) BlockResults(ctx context.Context, height *int64) (*ctypes.ResultBlockResults, error) {
+	res := new(ctypes.ResultBlockResults)
+	err := c.r.do(tmEncoding{}, "block_results", heightRequest(height), res, func() (interface{}, error) {
+		return c.client.BlockResults(ctx, height)
+	})
+	return res, err
+}
+
+func (c tmClient) Commit(ctx context.Context, height *int64) (*ctypes.ResultCommit, error) {
+	res := new(ctypes.ResultCommit)
+	err := c.r.do(tmEncoding{}, "commit", heightRequest(height), res, func() (interface{}, error) {
+		return c.client.Commit(ctx, height)
+	})
+	return res, err
+}
+
+func (c tmClient) Validators(ctx context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error) {
+	req := struct {
+		Height  *int64 `json:"height"`
+		Page    *int   `json:"page"`
+		PerPage *int   `json:"per_page"`
+	}{height, page, perPage}
+
+	res := new(ctypes.ResultValidators)
+	err := c.r.do(tmEncoding{}, "validators", req, res, func() (interface{}, error) {
+		return c.client.Validators(ctx, height, page, perPage)
+	})
+	return res, err
+}
+
+func (c tmClient) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
+	req := struct {
+		Hash  tmbytes.HexBytes `json:"hash"`
+		Prove bool             `json:"prove"`
+	}{hash, prove}
+
+	res := new(ctypes.ResultTx)
+	err := c.r.do(tmEncoding{}, "tx", req, res, func() (interface{}, error) {
+		return c.client.Tx(ctx, hash, prove)
+	})
+	return res, err
+}
+
+func (c tmClient) TxSearch(ctx context.Context, query string, prove bool, page, perPage *int,
+	orderBy string) (*ctypes.ResultTxSearch, error) {
+	req := struct {
+		Query   string `json:"query"`
+		Prove   bool   `json:"prove"`
+		Page    *int   `json:"page"`
+		PerPage *int   `json:"per_page"`
+		OrderBy string `json:"order_by"`
+	}{query, prove, page, perPage, orderBy}
+
+	res := new(ctypes.ResultTxSearch)
+	err := c.r.do(tmEncoding{}, "tx_search", req, res, func() (interface{}, error) {
+		return c.client.TxSearch(ctx, query, prove, page, perPage, orderBy)
+	})
+	return res, err
+}
+
+func (c tmClient) Status(ctx context.Context) (*ctypes.ResultStatus, error) {
+	res := new(ctypes.ResultStatus)
+	err := c.r.do(tmEncoding{}, "status", struct{}{}, res, func() (interface{}, error) {
+		return c.client.Status(ctx)
+	})
+	return res, err
+}
+
+func (c tmClient) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
+	res := new(ctypes.ResultNetInfo)
+	err := c.r.do(tmEncoding{}, "net_info", struct{}{}, res, func() (interface{}, error) {
+		return c.client.NetInfo(ctx)
+	})
+	return res, err
+}
+
+func (c tmClient) DumpConsensusState(ctx context.Context) (*ctypes.ResultDumpConsensusState, error) {
+	res := new(ctypes.ResultDumpConsensusState)
+	err := c.r.do(tmEncoding{}, "dump_consensus_state", struct{}{}, res, func() (interface{}, error) {
+		return c.client.DumpConsensusState(ctx)
+	})
+	return res, err
+}
+
+func (c tmClient) ConsensusState(ctx context.Context) (*ctypes.ResultConsensusState, error) {
+	res := new(ctypes.ResultConsensusState)
+	err := c.r.do(tmEncoding{}, "consensus_state", struct{}{}, res, func() (interface{}, error) {
+		return c.client.ConsensusState(ctx)
+	})
+	return res, err
+}
+
+func (c tmClient) ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
+	res := new(ctypes.ResultConsensusParams)
+	err := c.r.do(tmEncoding{}, "consensus_params", heightRequest(height), res, func() (interface{}, error) {
+		return c.client.ConsensusParams(ctx, height)
+	})
+	return res, err
+}
+
+func (c tmClient) Health(ctx context.Context) (*ctypes.ResultHealth, error) {
+	res := new(ctypes.ResultHealth)
+	err := c.r.do(tmEncoding{}, "health", struct{}{}, res, func() (interface{}, error) {
+		return c.client.Health(ctx)
+	})
+	return res, err
+}
+
+func (c tmClient) SubscribeNewBlock(builder *sdk.EventQueryBuilder, handler sdk.EventNewBlockHandler) (sdk.Subscription, sdk.Error) {
+	if builder == nil {
+		builder = sdk.NewEventQueryBuilder()
+	}
+	return c.subscribe("subscribe_new_block", builder.Build(), newBlockEvents,
+		func(handle sdk.EventHandler) (sdk.Subscription, sdk.Error) {
+			return c.client.SubscribeNewBlock(builder, func(data sdk.EventDataNewBlock) { handle(data) })
+		},
+		func(data sdk.EventData) { handler(data.(sdk.EventDataNewBlock)) },
+	)
+}
+
+func (c tmClient) SubscribeTx(builder *sdk.EventQueryBuilder, handler sdk.EventTxHandler) (sdk.Subscription, sdk.Error) {
+	if builder == nil {
+		builder = sdk.NewEventQueryBuilder()
+	}
+	return c.subscribe("subscribe_tx", builder.Build(), txEvents,
+		func(handle sdk.EventHandler) (sdk.Subscription, sdk.Error) {
+			return c.client.SubscribeTx(builder, func(data sdk.EventDataTx) { handle(data) })
+		},
+		func(data sdk.EventData) { handler(data.(sdk.EventDataTx)) },
+	)
+}
+
+func (c tmClient) SubscribeNewBlockHeader(handler sdk.EventNewBlockHeaderHandler) (sdk.Subscription, sdk.Error) {
+	return c.subscribe("subscribe_new_block_header", "", newBlockHeaderEvents,
+		func(handle sdk.EventHandler) (sdk.Subscription, sdk.Error) {
+			return c.client.SubscribeNewBlockHeader(func(data sdk.EventDataNewBlockHeader) { handle(data) })
+		},
+		func(data sdk.EventData) { handler(data.(sdk.EventDataNewBlockHeader)) },
+	)
+}
+
+func (c tmClient) SubscribeValidatorSetUpdates(handler sdk.EventValidatorSetUpdatesHandler) (sdk.Subscription, sdk.Error) {
+	return c.subscribe("subscribe_validator_set_updates", "", validatorSetUpdatesEvents,
+		func(handle sdk.EventHandler) (sdk.Subscription, sdk.Error) {
+			return c.client.SubscribeValidatorSetUpdates(func(data sdk.EventDataValidatorSetUpdates) { handle(data) })
+		},
+		func(data sdk.EventData) { handler(data.(sdk.EventDataValidatorSetUpdates)) },
+	)
+}
+
+func (c tmClient) Unsubscribe(subscription sdk.Subscription) sdk.Error {
+	req := struct {
+		ID string `json:"id"`
+	}{subscription.ID}
+
+	if c.r.mode == Replay {
+		c.r.stopEvents(subscription.ID)
+	}
+	return sdk.Wrap(c.r.do(jsonEncoding{}, "unsubscribe", req, &struct{}{}, func() (interface{}, error) {
+		if err := c.client.Unsubscribe(subscription); err != nil {
+			return nil, err
+		}
+		return &struct{}{}, nil
+	}))
+}
+
+// subscribe records the subscription performed by exec and the events it receives, or replays them.
+// query identifies the subscription in the fixtures, events converts its events from and to JSON
+func (c tmClient) subscribe(method, query string, events eventCodec,
+	exec func(handle sdk.EventHandler) (sdk.Subscription, sdk.Error), handler sdk.EventHandler) (sdk.Subscription, sdk.Error) {
+	req := struct {
+		Query string `json:"query"`
+	}{query}
+
+	switch c.r.mode {
+	case Passthrough:
+		return exec(handler)
+	case Replay:
+		subscription := new(sdk.Subscription)
+		if err := c.r.do(jsonEncoding{}, method, req, subscription, nil); err != nil {
+			return sdk.Subscription{}, sdk.Wrap(err)
+		}
+
+		subscription.Ctx = context.Background()
+		c.r.replayEvents(subscription.ID, func(event []byte) {
+			if data, err := events.decode(event); err == nil {
+				handler(data)
+			}
+		})
+		return *subscription, nil
+	}
+
+	// the events are recorded once the subscription is, so that they follow it in the fixtures
+	var id string
+	recorded := make(chan struct{})
+	subscription := new(sdk.Subscription)
+	err := c.r.do(jsonEncoding{}, method, req, subscription, func() (interface{}, error) {
+		sub, err := exec(func(data sdk.EventData) {
+			<-recorded
+			if event, err := events.encode(data); err == nil {
+				_ = c.r.recordEvent(id, event)
+			}
+			handler(data)
+		})
+		if err != nil {
+			return nil, err
+		}
+		id = sub.ID
+		return &sub, nil
+	})
+	close(recorded)
+	return *subscription, sdk.Wrap(err)
+}
+func txRequest(tx tmtypes.Tx) interface{} {
+	return struct {
+		Tx []byte `json:"tx"`
+	}{tx}
+}
+
+func heightRequest(height *int64) interface{} {
+	return struct {
+		Height *int64 `json:"height"`
+	}{height}
+}
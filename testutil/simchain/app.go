@@ -0,0 +1,618 @@
+package simchain
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/irisnet/irishub-sdk-go/modules/auth"
+	"github.com/irisnet/irishub-sdk-go/modules/bank"
+	"github.com/irisnet/irishub-sdk-go/modules/distribution"
+	"github.com/irisnet/irishub-sdk-go/modules/mint"
+	"github.com/irisnet/irishub-sdk-go/modules/slashing"
+	"github.com/irisnet/irishub-sdk-go/modules/staking"
+	"github.com/irisnet/irishub-sdk-go/modules/token"
+	"github.com/irisnet/irishub-sdk-go/modules/transfer"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/tx/signing"
+)
+
+// error codes of the cosmos-sdk root codespace returned by the chain
+const (
+	codeTxDecode          uint32 = 2
+	codeInvalidSequence   uint32 = 3
+	codeUnauthorized      uint32 = 4
+	codeInsufficientFunds uint32 = 5
+	codeUnknownRequest    uint32 = 6
+	codeInvalidPubKey     uint32 = 8
+	codeUnknownAddress    uint32 = 9
+	codeOutOfGas          uint32 = 11
+	codeMemoTooLarge      uint32 = 12
+	codeNoSignatures      uint32 = 15
+	codeInvalidRequest    uint32 = 18
+)
+
+// msgGasCost is the flat amount of gas consumed by the execution of every message
+const msgGasCost = 20000
+
+// runTxMode is the execution mode of a transaction
+type runTxMode uint8
+
+const (
+	// modeCheck only runs the ante checks like the CheckTx of a node
+	modeCheck runTxMode = iota
+	// modeDeliver runs the ante checks and executes the messages
+	modeDeliver
+	// modeSimulate executes the transaction without checking the signatures and the gas limit
+	modeSimulate
+)
+
+var (
+	errorDescs = map[uint32]string{
+		codeTxDecode:          "tx parse error",
+		codeInvalidSequence:   "incorrect account sequence",
+		codeUnauthorized:      "unauthorized",
+		codeInsufficientFunds: "insufficient funds",
+		codeUnknownRequest:    "unknown request",
+		codeInvalidPubKey:     "invalid pubkey",
+		codeUnknownAddress:    "unknown address",
+		codeOutOfGas:          "out of gas",
+		codeMemoTooLarge:      "memo too large",
+		codeNoSignatures:      "no signatures supplied",
+		codeInvalidRequest:    "invalid request",
+	}
+
+	feeCollector = sdk.AccAddress(tmcrypto.AddressHash([]byte("fee_collector"))).String()
+	// bondedPool holds the tokens of the bonded validators
+	bondedPool = sdk.AccAddress(tmcrypto.AddressHash([]byte("bonded_tokens_pool"))).String()
+	// distributionPool holds the rewards, the commissions and the community pool
+	distributionPool = sdk.AccAddress(tmcrypto.AddressHash([]byte("distribution"))).String()
+)
+
+// txError is a failed execution reported with its abci code
+type txError struct {
+	code uint32
+	log  string
+}
+
+func newTxError(code uint32, format string, args ...interface{}) *txError {
+	return &txError{
+		code: code,
+		log:  fmt.Sprintf("%s: %s", fmt.Sprintf(format, args...), errorDescs[code]),
+	}
+}
+
+// sigTx is the transaction decoded by the tx config of the sdk
+type sigTx interface {
+	sdk.FeeTx
+	GetMemo() string
+	GetSigners() []sdk.AccAddress
+	GetSignatures() [][]byte
+	GetSignaturesV2() ([]signing.SignatureV2, error)
+}
+
+// state is the application state of the chain
+type state struct {
+	accounts    map[string]auth.BaseAccount
+	balances    map[string]sdk.Coins
+	tokens      map[string]token.Token
+	denomTraces map[string]transfer.DenomTrace
+	nextAccount uint64
+	authParams  auth.Params
+	bankParams  bank.Params
+	tokenParams token.Params
+	// transferParams enable the sends and receives of the ibc transfers
+	transferParams transfer.Params
+	// mintParams are the mint denom and the annual inflation of the irishub mint module
+	mintParams mint.Params
+	// minter is served by the store queries of the mint module, its inflation base sets the annual provisions
+	minter mint.Minter
+	// distrParams set the community tax of the staking rewards
+	distrParams distribution.Params
+	// rewards are the rewards of the delegations by delegator and validator, held by the distribution pool
+	rewards map[string]map[string]sdk.DecCoins
+	// commissions are the accumulated commissions of the validators, held by the distribution pool
+	commissions   map[string]sdk.DecCoins
+	slashes       map[string][]slashEvent
+	withdrawAddrs map[string]string
+	communityPool sdk.DecCoins
+	// validators are the validators of the staking queries, they do not sign the blocks
+	validators []staking.Validator
+	// signingInfos are the signing infos of the validators by consensus address
+	signingInfos   map[string]slashing.ValidatorSigningInfo
+	slashingParams slashing.Params
+}
+
+func (s *state) clone() *state {
+	cpy := *s
+	cpy.accounts = make(map[string]auth.BaseAccount, len(s.accounts))
+	for addr, acc := range s.accounts {
+		cpy.accounts[addr] = acc
+	}
+	cpy.balances = make(map[string]sdk.Coins, len(s.balances))
+	for addr, coins := range s.balances {
+		cpy.balances[addr] = coins
+	}
+	cpy.tokens = make(map[string]token.Token, len(s.tokens))
+	for symbol, t := range s.tokens {
+		cpy.tokens[symbol] = t
+	}
+	cpy.denomTraces = make(map[string]transfer.DenomTrace, len(s.denomTraces))
+	for hash, trace := range s.denomTraces {
+		cpy.denomTraces[hash] = trace
+	}
+	cpy.validators = append([]staking.Validator(nil), s.validators...)
+	cpy.signingInfos = make(map[string]slashing.ValidatorSigningInfo, len(s.signingInfos))
+	for addr, info := range s.signingInfos {
+		cpy.signingInfos[addr] = info
+	}
+	cpy.rewards = make(map[string]map[string]sdk.DecCoins, len(s.rewards))
+	for delegator, rewards := range s.rewards {
+		cpy.rewards[delegator] = make(map[string]sdk.DecCoins, len(rewards))
+		for validator, r := range rewards {
+			cpy.rewards[delegator][validator] = r
+		}
+	}
+	cpy.commissions = make(map[string]sdk.DecCoins, len(s.commissions))
+	for validator, commission := range s.commissions {
+		cpy.commissions[validator] = commission
+	}
+	cpy.slashes = make(map[string][]slashEvent, len(s.slashes))
+	for validator, slashes := range s.slashes {
+		cpy.slashes[validator] = slashes
+	}
+	cpy.withdrawAddrs = make(map[string]string, len(s.withdrawAddrs))
+	for delegator, addr := range s.withdrawAddrs {
+		cpy.withdrawAddrs[delegator] = addr
+	}
+	return &cpy
+}
+
+// slashEvent is a slash of a validator recorded by the distribution module
+type slashEvent struct {
+	height uint64
+	event  distribution.ValidatorSlashEvent
+}
+
+// withdrawAddr returns the address receiving the rewards of the delegator
+func (s *state) withdrawAddr(delegator string) string {
+	if addr, ok := s.withdrawAddrs[delegator]; ok {
+		return addr
+	}
+	return delegator
+}
+
+// validatorIndex returns the index of the validator of the operator address, -1 if it does not exist
+func (s *state) validatorIndex(operator string) int {
+	for i, v := range s.validators {
+		if v.OperatorAddress == operator {
+			return i
+		}
+	}
+	return -1
+}
+
+// delegatorValidators returns the sorted addresses of the validators the delegator delegates to
+func (s *state) delegatorValidators(delegator string) []string {
+	validators := make([]string, 0, len(s.rewards[delegator]))
+	for validator := range s.rewards[delegator] {
+		validators = append(validators, validator)
+	}
+	sort.Strings(validators)
+	return validators
+}
+
+// outstandingRewards returns the rewards of the delegations to the validator and its commission
+func (s *state) outstandingRewards(validator string) sdk.DecCoins {
+	outstanding := s.commissions[validator]
+	for _, rewards := range s.rewards {
+		outstanding = outstanding.Add(rewards[validator]...)
+	}
+	return outstanding
+}
+
+// withdraw pays the integral part of the amount from the distribution pool to the address,
+// the decimal change is returned
+func (s *state) withdraw(address string, amount sdk.DecCoins) (sdk.Coins, sdk.DecCoins, []abci.Event, *txError) {
+	coins, change := amount.TruncateDecimal()
+	if coins.IsZero() {
+		return coins, change, nil, nil
+	}
+	if txErr := s.subCoins(distributionPool, coins); txErr != nil {
+		return nil, nil, nil, txErr
+	}
+	s.addCoins(address, coins)
+	return coins, change, transferEvents(distributionPool, address, coins), nil
+}
+
+// account returns the account of the address, creating it when create is true
+func (s *state) account(address string, create bool) (auth.BaseAccount, bool) {
+	acc, ok := s.accounts[address]
+	if ok || !create {
+		return acc, ok
+	}
+
+	acc = auth.BaseAccount{
+		Address:       address,
+		AccountNumber: s.nextAccount,
+	}
+	s.nextAccount++
+	s.accounts[address] = acc
+	return acc, true
+}
+
+func (s *state) addCoins(address string, coins sdk.Coins) {
+	s.account(address, true)
+	s.balances[address] = s.balances[address].Add(coins...)
+}
+
+func (s *state) subCoins(address string, coins sdk.Coins) *txError {
+	balance := s.balances[address]
+	remain, hasNeg := balance.SafeSub(coins)
+	if hasNeg {
+		return newTxError(codeInsufficientFunds, "%s is smaller than %s", balance, coins)
+	}
+	s.balances[address] = remain
+	return nil
+}
+
+func (s *state) supply() sdk.Coins {
+	var supply sdk.Coins
+	for _, coins := range s.balances {
+		supply = supply.Add(coins...)
+	}
+	return supply
+}
+
+// token returns the token whose symbol or min unit is denom
+func (s *state) token(denom string) (token.Token, bool) {
+	if t, ok := s.tokens[denom]; ok {
+		return t, true
+	}
+	for _, t := range s.tokens {
+		if t.MinUnit == denom {
+			return t, true
+		}
+	}
+	return token.Token{}, false
+}
+
+// hash returns a deterministic digest of the state used as the app hash
+func (s *state) hash() []byte {
+	type entry struct {
+		Account auth.BaseAccount `json:"account"`
+		Coins   sdk.Coins        `json:"coins"`
+	}
+
+	addrs := make([]string, 0, len(s.accounts))
+	for addr := range s.accounts {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+
+	entries := make([]entry, len(addrs))
+	for i, addr := range addrs {
+		acc := s.accounts[addr]
+		acc.PubKey = nil
+		entries[i] = entry{Account: acc, Coins: s.balances[addr]}
+	}
+
+	bz, _ := json.Marshal(entries)
+	hash := sha256.Sum256(bz)
+	return hash[:]
+}
+
+// runTx executes the transaction against the state like the ante handler and the
+// message router of the cosmos-sdk: fees and sequences are committed once the ante
+// checks pass, the messages are only committed when all of them succeed.
+func (c *Chain) runTx(s *state, txBytes []byte, mode runTxMode) abci.ResponseDeliverTx {
+	decoded, err := c.encodingConfig.TxConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return errorResponse(newTxError(codeTxDecode, "%s", err.Error()), 0, 0)
+	}
+
+	tx, ok := decoded.(sigTx)
+	if !ok {
+		return errorResponse(newTxError(codeTxDecode, "invalid transaction type %T", decoded), 0, 0)
+	}
+
+	simulate := mode == modeSimulate
+	gasWanted := tx.GetGas()
+	gasUsed, anteEvents, txErr := c.ante(s, tx, len(txBytes), simulate)
+	if txErr != nil {
+		return errorResponse(txErr, gasWanted, gasUsed)
+	}
+
+	if mode == modeCheck {
+		return abci.ResponseDeliverTx{
+			Log:       "[]",
+			GasWanted: int64(gasWanted),
+			GasUsed:   int64(gasUsed),
+			Events:    anteEvents,
+		}
+	}
+
+	msgState := s.clone()
+	var events []abci.Event
+	var logs sdk.ABCIMessageLogs
+	for i, msg := range tx.GetMsgs() {
+		gasUsed += msgGasCost
+		msgEvents, txErr := handleMsg(msgState, msg)
+		if txErr != nil {
+			txErr.log = fmt.Sprintf("failed to execute message; message index: %d: %s", i, txErr.log)
+			return errorResponse(txErr, gasWanted, gasUsed)
+		}
+
+		msgEvents = append([]abci.Event{newEvent(sdk.EventTypeMessage, sdk.AttributeKeyAction, msg.Type())}, msgEvents...)
+		events = append(events, msgEvents...)
+		logs = append(logs, sdk.ABCIMessageLog{
+			MsgIndex: uint32(i),
+			Events:   sdk.StringifyEvents(msgEvents),
+		})
+	}
+
+	if !simulate && gasUsed > gasWanted {
+		return errorResponse(outOfGas("message execution", gasWanted, gasUsed), gasWanted, gasUsed)
+	}
+	*s = *msgState
+
+	log, _ := json.Marshal(logs)
+	return abci.ResponseDeliverTx{
+		Log:       string(log),
+		GasWanted: int64(gasWanted),
+		GasUsed:   int64(gasUsed),
+		Events:    append(anteEvents, events...),
+	}
+}
+
+// ante validates the transaction, verifies the signatures and deducts the fees
+func (c *Chain) ante(s *state, tx sigTx, txSize int, simulate bool) (uint64, []abci.Event, *txError) {
+	if err := tx.ValidateBasic(); err != nil {
+		code := codeInvalidRequest
+		if len(tx.GetSignatures()) == 0 {
+			code = codeNoSignatures
+		}
+		return 0, nil, newTxError(code, "%s", err.Error())
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if err := msg.ValidateBasic(); err != nil {
+			return 0, nil, newTxError(codeInvalidRequest, "%s", err.Error())
+		}
+	}
+
+	params := s.authParams
+	if uint64(len(tx.GetMemo())) > params.MaxMemoCharacters {
+		return 0, nil, newTxError(codeMemoTooLarge,
+			"maximum number of characters is %d but received %d characters",
+			params.MaxMemoCharacters, len(tx.GetMemo()),
+		)
+	}
+
+	gasUsed := params.TxSizeCostPerByte * uint64(txSize)
+
+	sigs, err := tx.GetSignaturesV2()
+	if err != nil {
+		return gasUsed, nil, newTxError(codeTxDecode, "%s", err.Error())
+	}
+
+	signers := tx.GetSigners()
+	if len(sigs) != len(signers) {
+		return gasUsed, nil, newTxError(codeUnauthorized, "invalid number of signer; expected: %d, got %d", len(signers), len(sigs))
+	}
+
+	accounts := make([]auth.BaseAccount, len(signers))
+	for i, signer := range signers {
+		acc, ok := s.account(signer.String(), false)
+		if !ok {
+			return gasUsed, nil, newTxError(codeUnknownAddress, "account %s does not exist", signer)
+		}
+
+		sig := sigs[i]
+		if sig.PubKey == nil || !signer.Equals(sdk.AccAddress(sig.PubKey.Address())) {
+			return gasUsed, nil, newTxError(codeInvalidPubKey, "pubKey does not match signer address %s with signer index: %d", signer, i)
+		}
+
+		if sig.Sequence != acc.Sequence {
+			return gasUsed, nil, newTxError(codeInvalidSequence, "account sequence mismatch, expected %d, got %d", acc.Sequence, sig.Sequence)
+		}
+
+		switch sig.PubKey.(type) {
+		case ed25519.PubKey:
+			gasUsed += params.SigVerifyCostED25519
+		default:
+			gasUsed += params.SigVerifyCostSecp256k1
+		}
+
+		if !simulate {
+			if err := c.verifySignature(tx, sig, acc.AccountNumber); err != nil {
+				return gasUsed, nil, err
+			}
+		}
+
+		if acc.PubKey == nil {
+			if err := acc.SetPubKey(sig.PubKey); err != nil {
+				return gasUsed, nil, newTxError(codeInvalidPubKey, "%s", err.Error())
+			}
+		}
+		accounts[i] = acc
+	}
+
+	if !simulate && gasUsed > tx.GetGas() {
+		return gasUsed, nil, outOfGas("ante handler", tx.GetGas(), gasUsed)
+	}
+
+	var events []abci.Event
+	if fee := tx.GetFee(); !fee.IsZero() {
+		payer := tx.FeePayer().String()
+		if txErr := s.subCoins(payer, fee); txErr != nil {
+			txErr.log = "insufficient funds to pay for fees; " + txErr.log
+			return gasUsed, nil, txErr
+		}
+		s.addCoins(feeCollector, fee)
+		events = transferEvents(payer, feeCollector, fee)
+	}
+
+	for _, acc := range accounts {
+		acc.Sequence++
+		s.accounts[acc.Address] = acc
+	}
+	return gasUsed, events, nil
+}
+
+func (c *Chain) verifySignature(tx sigTx, sig signing.SignatureV2, accountNumber uint64) *txError {
+	data, ok := sig.Data.(*signing.SingleSignatureData)
+	if !ok {
+		return newTxError(codeUnauthorized, "multisig signatures are not supported")
+	}
+
+	signerData := sdk.SignerData{
+		ChainID:       c.chainID,
+		AccountNumber: accountNumber,
+		Sequence:      sig.Sequence,
+	}
+	signBytes, err := c.encodingConfig.TxConfig.SignModeHandler().GetSignBytes(data.SignMode, signerData, tx)
+	if err != nil {
+		return newTxError(codeUnauthorized, "%s", err.Error())
+	}
+
+	if !sig.PubKey.VerifySignature(signBytes, data.Signature) {
+		return newTxError(codeUnauthorized,
+			"signature verification failed; please verify account number (%d) and chain-id (%s)",
+			accountNumber, c.chainID,
+		)
+	}
+	return nil
+}
+
+// handleMsg applies the bank, distribution and unjail messages, other messages are rejected like unrouted messages.
+// The jail period of the signing info is not checked by the unjail.
+func handleMsg(s *state, msg sdk.Msg) ([]abci.Event, *txError) {
+	switch msg := msg.(type) {
+	case *bank.MsgSend:
+		if txErr := s.subCoins(msg.FromAddress, msg.Amount); txErr != nil {
+			return nil, txErr
+		}
+		s.addCoins(msg.ToAddress, msg.Amount)
+
+		events := transferEvents(msg.FromAddress, msg.ToAddress, msg.Amount)
+		return append(events, newEvent(sdk.EventTypeMessage, sdk.AttributeKeyModule, bank.ModuleName)), nil
+
+	case *bank.MsgMultiSend:
+		var events []abci.Event
+		for _, in := range msg.Inputs {
+			if txErr := s.subCoins(in.Address, in.Coins); txErr != nil {
+				return nil, txErr
+			}
+			events = append(events, newEvent(sdk.EventTypeMessage, sdk.AttributeKeySender, in.Address))
+		}
+		for _, out := range msg.Outputs {
+			s.addCoins(out.Address, out.Coins)
+			events = append(events, newEvent(
+				eventTypeTransfer,
+				attributeKeyRecipient, out.Address,
+				sdk.AttributeKeyAmount, out.Coins.String(),
+			))
+		}
+		return append(events, newEvent(sdk.EventTypeMessage, sdk.AttributeKeyModule, bank.ModuleName)), nil
+
+	case *distribution.MsgSetWithdrawAddress:
+		if !s.distrParams.WithdrawAddrEnabled {
+			return nil, newTxError(codeUnauthorized, "set withdraw address disabled")
+		}
+		if msg.WithdrawAddress == msg.DelegatorAddress {
+			delete(s.withdrawAddrs, msg.DelegatorAddress)
+		} else {
+			s.withdrawAddrs[msg.DelegatorAddress] = msg.WithdrawAddress
+		}
+		return distributionEvents(msg.DelegatorAddress,
+			newEvent("set_withdraw_address", "withdraw_address", msg.WithdrawAddress),
+		), nil
+
+	case *distribution.MsgWithdrawDelegatorReward:
+		rewards, ok := s.rewards[msg.DelegatorAddress][msg.ValidatorAddress]
+		if !ok {
+			return nil, newTxError(codeInvalidRequest, "no delegation for (address, validator) tuple")
+		}
+		coins, change, events, txErr := s.withdraw(s.withdrawAddr(msg.DelegatorAddress), rewards)
+		if txErr != nil {
+			return nil, txErr
+		}
+		// the change of the rewards goes to the community pool
+		s.rewards[msg.DelegatorAddress][msg.ValidatorAddress] = nil
+		s.communityPool = s.communityPool.Add(change...)
+		return append(events, distributionEvents(msg.DelegatorAddress,
+			newEvent("withdraw_rewards", sdk.AttributeKeyAmount, coins.String(), "validator", msg.ValidatorAddress),
+		)...), nil
+
+	case *distribution.MsgWithdrawValidatorCommission:
+		commission := s.commissions[msg.ValidatorAddress]
+		if commission.IsZero() {
+			return nil, newTxError(codeInvalidRequest, "no validator commission to withdraw")
+		}
+		operator := msg.GetSigners()[0].String()
+		coins, change, events, txErr := s.withdraw(s.withdrawAddr(operator), commission)
+		if txErr != nil {
+			return nil, txErr
+		}
+		// the change of the commission stays with the validator
+		s.commissions[msg.ValidatorAddress] = change
+		return append(events, distributionEvents(operator,
+			newEvent("withdraw_commission", sdk.AttributeKeyAmount, coins.String()),
+		)...), nil
+
+	case *distribution.MsgFundCommunityPool:
+		if txErr := s.subCoins(msg.Depositor, msg.Amount); txErr != nil {
+			return nil, txErr
+		}
+		s.addCoins(distributionPool, msg.Amount)
+		s.communityPool = s.communityPool.Add(sdk.NewDecCoinsFromCoins(msg.Amount...)...)
+
+		events := transferEvents(msg.Depositor, distributionPool, msg.Amount)
+		return append(events, distributionEvents(msg.Depositor)...), nil
+
+	case *slashing.MsgUnjail:
+		i := s.validatorIndex(msg.ValidatorAddr)
+		if i < 0 {
+			return nil, newTxError(codeInvalidRequest, "address is not associated with any known validator")
+		}
+		if !s.validators[i].Jailed {
+			return nil, newTxError(codeInvalidRequest, "validator not jailed; cannot be unjailed")
+		}
+		s.validators[i].Jailed = false
+		return []abci.Event{newEvent(sdk.EventTypeMessage,
+			sdk.AttributeKeyModule, slashing.ModuleName,
+			sdk.AttributeKeySender, msg.GetSigners()[0].String(),
+		)}, nil
+
+	default:
+		return nil, newTxError(codeUnknownRequest, "unrecognized %s message type: %T", msg.Route(), msg)
+	}
+}
+
+// distributionEvents returns the events of a distribution message sent by the sender
+func distributionEvents(sender string, events ...abci.Event) []abci.Event {
+	return append(events, newEvent(sdk.EventTypeMessage,
+		sdk.AttributeKeyModule, distribution.ModuleName,
+		sdk.AttributeKeySender, sender,
+	))
+}
+
+func outOfGas(location string, gasWanted, gasUsed uint64) *txError {
+	return newTxError(codeOutOfGas, "out of gas in location: %s; gasWanted: %d, gasUsed: %d", location, gasWanted, gasUsed)
+}
+
+func errorResponse(err *txError, gasWanted, gasUsed uint64) abci.ResponseDeliverTx {
+	return abci.ResponseDeliverTx{
+		Code:      err.code,
+		Codespace: sdk.RootCodespace,
+		Log:       err.log,
+		GasWanted: int64(gasWanted),
+		GasUsed:   int64(gasUsed),
+	}
+}
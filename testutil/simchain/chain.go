@@ -0,0 +1,1051 @@
+// Package simchain provides an in-process irishub chain for unit tests. It serves the
+// tendermint rpc client and the grpc queries used by the sdk without starting a node:
+// every broadcasted transaction is executed against an in-memory state and committed in
+// its own deterministic block signed by a single validator.
+//
+// Bank sends, distribution messages and unjails are executed with the fees, sequences, events and error
+// codes of the cosmos-sdk, the other messages are rejected as unknown requests.
+package simchain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/p2p"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/modules/auth"
+	"github.com/irisnet/irishub-sdk-go/modules/bank"
+	"github.com/irisnet/irishub-sdk-go/modules/distribution"
+	"github.com/irisnet/irishub-sdk-go/modules/mint"
+	"github.com/irisnet/irishub-sdk-go/modules/slashing"
+	"github.com/irisnet/irishub-sdk-go/modules/staking"
+	"github.com/irisnet/irishub-sdk-go/modules/token"
+	"github.com/irisnet/irishub-sdk-go/modules/transfer"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	defaultChainID       = "simchain"
+	defaultBlockInterval = 5 * time.Second
+	defaultPerPage       = 30
+	maxPerPage           = 100
+	validatorPower       = 100
+
+	eventTypeTransfer     = "transfer"
+	attributeKeyRecipient = "recipient"
+)
+
+var (
+	_ sdk.TmClient   = (*Chain)(nil)
+	_ sdk.GRPCClient = (*Chain)(nil)
+
+	defaultGenesisTime = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// Chain is an in-process chain implementing sdk.TmClient and sdk.GRPCClient
+type Chain struct {
+	mtx sync.RWMutex
+
+	chainID        string
+	genesisTime    time.Time
+	blockInterval  time.Duration
+	encodingConfig sdk.EncodingConfig
+	amino          *codec.LegacyAmino
+
+	state   *state
+	blocks  []*block
+	txs     map[string]*ctypes.ResultTx
+	txIndex []*ctypes.ResultTx
+
+	privVal    tmtypes.PrivValidator
+	validators *tmtypes.ValidatorSet
+
+	subscribers map[string]subscriber
+	nextSubID   int
+
+	listener *bufconn.Listener
+	server   *grpc.Server
+	// restServices are the grpc services routed by the REST gateway
+	restServices map[string]grpc.ServiceInfo
+	gateway      *httptest.Server
+}
+
+type block struct {
+	*tmtypes.Block
+	id      tmtypes.BlockID
+	commit  *tmtypes.Commit
+	results []*abci.ResponseDeliverTx
+}
+
+type subscriber struct {
+	query   *query.Query
+	handler sdk.EventHandler
+}
+
+// Option configures the genesis of the chain
+type Option func(c *Chain) error
+
+// ChainIDOption sets the chain id, "simchain" by default
+func ChainIDOption(chainID string) Option {
+	return func(c *Chain) error {
+		if len(chainID) == 0 {
+			return fmt.Errorf("chain id is required")
+		}
+		c.chainID = chainID
+		return nil
+	}
+}
+
+// GenesisTimeOption sets the time of the first block
+func GenesisTimeOption(genesisTime time.Time) Option {
+	return func(c *Chain) error {
+		c.genesisTime = genesisTime.UTC()
+		return nil
+	}
+}
+
+// BlockIntervalOption sets the time elapsed between two blocks
+func BlockIntervalOption(interval time.Duration) Option {
+	return func(c *Chain) error {
+		if interval <= 0 {
+			return fmt.Errorf("block interval must be positive")
+		}
+		c.blockInterval = interval
+		return nil
+	}
+}
+
+// BalanceOption funds the account of the address in the genesis
+func BalanceOption(address string, coins ...sdk.Coin) Option {
+	return func(c *Chain) error {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return err
+		}
+
+		amount := sdk.NewCoins(coins...)
+		if !amount.IsValid() {
+			return fmt.Errorf("invalid coins %s", amount)
+		}
+		c.state.addCoins(address, amount)
+		return nil
+	}
+}
+
+// TokenOption registers the tokens in the genesis, the native token iris is always registered
+func TokenOption(tokens ...token.Token) Option {
+	return func(c *Chain) error {
+		for _, t := range tokens {
+			if len(t.Symbol) == 0 || len(t.MinUnit) == 0 {
+				return fmt.Errorf("symbol and min unit of the token are required")
+			}
+			c.state.tokens[t.Symbol] = t
+		}
+		return nil
+	}
+}
+
+// DenomTraceOption registers the traces of the ibc vouchers, their balances are funded by BalanceOption
+func DenomTraceOption(traces ...transfer.DenomTrace) Option {
+	return func(c *Chain) error {
+		for _, trace := range traces {
+			if len(trace.Path) == 0 || len(trace.BaseDenom) == 0 {
+				return fmt.Errorf("path and base denom of the denom trace are required")
+			}
+			hash := sdk.DenomTrace{Path: trace.Path, BaseDenom: trace.BaseDenom}.Hash()
+			c.state.denomTraces[hash.String()] = trace
+		}
+		return nil
+	}
+}
+
+// ValidatorOption registers the validators of the staking queries, the tokens of the bonded validators
+// are held by the bonded pool in the mint denom and their consensus pubkey is required. The blocks are still signed by the single validator of the chain.
+func ValidatorOption(validators ...staking.Validator) Option {
+	return func(c *Chain) error {
+		for _, v := range validators {
+			if _, err := sdk.ValAddressFromBech32(v.OperatorAddress); err != nil {
+				return err
+			}
+			if pubKey, err := v.GetPubKey(c.encodingConfig.Marshaler); err != nil || pubKey == nil {
+				return fmt.Errorf("invalid consensus pubkey of the validator %s", v.OperatorAddress)
+			}
+			if v.Tokens.IsNil() || v.Tokens.IsNegative() {
+				return fmt.Errorf("invalid tokens of the validator %s", v.OperatorAddress)
+			}
+			if v.Status == staking.Bonded && v.Tokens.IsPositive() {
+				c.state.addCoins(bondedPool, sdk.NewCoins(sdk.NewCoin(c.state.mintParams.MintDenom, v.Tokens)))
+			}
+			c.state.validators = append(c.state.validators, v)
+		}
+		return nil
+	}
+}
+
+// DelegationRewardOption registers the delegation of the delegator to the validator with its rewards
+// not withdrawn yet, the integral part of the rewards is held by the distribution pool
+func DelegationRewardOption(delegator, validator string, rewards ...sdk.DecCoin) Option {
+	return func(c *Chain) error {
+		if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
+			return err
+		}
+		if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+			return err
+		}
+
+		amount := sdk.NewDecCoins(rewards...)
+		if c.state.rewards[delegator] == nil {
+			c.state.rewards[delegator] = make(map[string]sdk.DecCoins)
+		}
+		c.state.rewards[delegator][validator] = c.state.rewards[delegator][validator].Add(amount...)
+		coins, _ := amount.TruncateDecimal()
+		c.state.addCoins(distributionPool, coins)
+		return nil
+	}
+}
+
+// CommissionOption sets the accumulated commission of the validator, its integral part is held by the distribution pool
+func CommissionOption(validator string, commission ...sdk.DecCoin) Option {
+	return func(c *Chain) error {
+		if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+			return err
+		}
+
+		amount := sdk.NewDecCoins(commission...)
+		c.state.commissions[validator] = c.state.commissions[validator].Add(amount...)
+		coins, _ := amount.TruncateDecimal()
+		c.state.addCoins(distributionPool, coins)
+		return nil
+	}
+}
+
+// ValidatorSlashOption records the slashes of the validator at the height for the distribution queries
+func ValidatorSlashOption(validator string, height uint64, fractions ...sdk.Dec) Option {
+	return func(c *Chain) error {
+		if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+			return err
+		}
+
+		for _, fraction := range fractions {
+			slashes := c.state.slashes[validator]
+			c.state.slashes[validator] = append(slashes, slashEvent{
+				height: height,
+				event: distribution.ValidatorSlashEvent{
+					ValidatorPeriod: uint64(len(slashes)) + 1,
+					Fraction:        fraction,
+				},
+			})
+		}
+		return nil
+	}
+}
+
+// SigningInfoOption sets the signing infos of the slashing queries, their address is the consensus address of the validator
+func SigningInfoOption(infos ...slashing.ValidatorSigningInfo) Option {
+	return func(c *Chain) error {
+		for _, info := range infos {
+			if _, err := consAddressFromBech32(info.Address); err != nil {
+				return err
+			}
+			c.state.signingInfos[info.Address] = info
+		}
+		return nil
+	}
+}
+
+// MinterOption sets the inflation base of the minter, the annual provisions are the inflation of the mint params times it
+func MinterOption(inflationBase sdk.Int) Option {
+	return func(c *Chain) error {
+		if inflationBase.IsNil() || inflationBase.IsNegative() {
+			return fmt.Errorf("invalid inflation base %s", inflationBase)
+		}
+		c.state.minter.InflationBase = inflationBase
+		return nil
+	}
+}
+
+// New creates a chain with the given genesis and commits its first block
+func New(options ...Option) (*Chain, error) {
+	privKey := ed25519.GenPrivKeyFromSecret([]byte("simchain validator"))
+	validator := tmtypes.NewValidator(privKey.PubKey(), validatorPower)
+
+	c := &Chain{
+		chainID:        defaultChainID,
+		genesisTime:    defaultGenesisTime,
+		blockInterval:  defaultBlockInterval,
+		encodingConfig: makeEncodingConfig(),
+		amino:          makeAmino(),
+		state:          defaultState(),
+		txs:            make(map[string]*ctypes.ResultTx),
+		privVal:        tmtypes.NewMockPVWithParams(privKey, false, false),
+		validators:     tmtypes.NewValidatorSet([]*tmtypes.Validator{validator}),
+		subscribers:    make(map[string]subscriber),
+	}
+
+	for _, optionFn := range options {
+		if err := optionFn(c); err != nil {
+			return nil, err
+		}
+	}
+
+	c.state.minter.LastUpdate = c.genesisTime
+	if _, err := c.commitBlock(nil, nil); err != nil {
+		return nil, err
+	}
+
+	c.startGRPCServer()
+	return c, nil
+}
+
+// ClientConfig returns the configuration of a client connected to the chain
+func (c *Chain) ClientConfig(options ...sdk.Option) (sdk.ClientConfig, error) {
+	options = append([]sdk.Option{
+		sdk.TmClientOption(c),
+		sdk.GRPCClientOption(c),
+	}, options...)
+	return sdk.NewClientConfig("simchain://"+c.chainID, bufnet, c.chainID, options...)
+}
+
+// ChainID returns the chain id of the chain
+func (c *Chain) ChainID() string {
+	return c.chainID
+}
+
+// Height returns the height of the latest block
+func (c *Chain) Height() int64 {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.latest().Height
+}
+
+// Balances returns the balances of the address
+func (c *Chain) Balances(address string) sdk.Coins {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.state.balances[address]
+}
+
+// Fund mints coins to the address in a new block
+func (c *Chain) Fund(address string, coins ...sdk.Coin) error {
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return err
+	}
+
+	c.mtx.Lock()
+	c.state.addCoins(address, sdk.NewCoins(coins...))
+	b, err := c.commitBlock(nil, nil)
+	c.mtx.Unlock()
+	if err != nil {
+		return err
+	}
+
+	c.publishBlock(b)
+	return nil
+}
+
+// NextBlock commits an empty block
+func (c *Chain) NextBlock() error {
+	c.mtx.Lock()
+	b, err := c.commitBlock(nil, nil)
+	c.mtx.Unlock()
+	if err != nil {
+		return err
+	}
+
+	c.publishBlock(b)
+	return nil
+}
+
+// Close stops the grpc server and the REST gateway of the chain
+func (c *Chain) Close() error {
+	c.mtx.Lock()
+	if c.gateway != nil {
+		c.gateway.Close()
+	}
+	c.mtx.Unlock()
+
+	c.server.Stop()
+	return c.listener.Close()
+}
+
+// =============================================================================
+// ABCIClient
+
+func (c *Chain) ABCIInfo(context.Context) (*ctypes.ResultABCIInfo, error) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	latest := c.latest()
+	return &ctypes.ResultABCIInfo{Response: abci.ResponseInfo{
+		Data:             "irishub",
+		LastBlockHeight:  latest.Height,
+		LastBlockAppHash: c.state.hash(),
+	}}, nil
+}
+
+func (c *Chain) ABCIQuery(ctx context.Context, path string, data tmbytes.HexBytes) (*ctypes.ResultABCIQuery, error) {
+	return c.ABCIQueryWithOptions(ctx, path, data, rpcclient.DefaultABCIQueryOptions)
+}
+
+// ABCIQueryWithOptions serves the simulation of transactions and the store query of the minter,
+// the other store queries are not supported
+func (c *Chain) ABCIQueryWithOptions(_ context.Context, path string, data tmbytes.HexBytes, _ rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	height := c.latest().Height
+	switch path {
+	case "/app/simulate":
+	case "/store/" + mint.StoreKey + "/key":
+		var value []byte
+		if bytes.Equal(data, mint.MinterKey) {
+			bz, err := c.encodingConfig.Marshaler.MarshalBinaryBare(&c.state.minter)
+			if err != nil {
+				return nil, err
+			}
+			value = bz
+		}
+		return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{
+			Key:    data,
+			Value:  value,
+			Height: height,
+		}}, nil
+	default:
+		txErr := newTxError(codeUnknownRequest, "unknown query path %s", path)
+		return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{
+			Code:      txErr.code,
+			Codespace: sdk.RootCodespace,
+			Log:       txErr.log,
+			Height:    height,
+		}}, nil
+	}
+
+	res := c.runTx(c.state.clone(), data, modeSimulate)
+	if res.IsErr() {
+		return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{
+			Code:      res.Code,
+			Codespace: res.Codespace,
+			Log:       res.Log,
+			Height:    height,
+		}}, nil
+	}
+
+	bz, err := c.encodingConfig.Marshaler.MarshalJSON(&sdk.SimulationResponse{
+		GasInfo: sdk.GasInfo{
+			GasWanted: uint64(res.GasWanted),
+			GasUsed:   uint64(res.GasUsed),
+		},
+		Result: &sdk.Result{
+			Log:    res.Log,
+			Events: res.Events,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{
+		Value:  bz,
+		Height: height,
+	}}, nil
+}
+
+// BroadcastTxCommit executes the transaction and commits it in a new block
+func (c *Chain) BroadcastTxCommit(_ context.Context, tx tmtypes.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
+	checkTx, deliverTx, height := c.broadcast(tx)
+	return &ctypes.ResultBroadcastTxCommit{
+		CheckTx:   checkTx,
+		DeliverTx: deliverTx,
+		Hash:      tx.Hash(),
+		Height:    height,
+	}, nil
+}
+
+// BroadcastTxAsync executes the transaction and commits it in a new block
+func (c *Chain) BroadcastTxAsync(_ context.Context, tx tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	c.broadcast(tx)
+	return &ctypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
+}
+
+// BroadcastTxSync executes the transaction and commits it in a new block, the result
+// of the ante checks is returned
+func (c *Chain) BroadcastTxSync(_ context.Context, tx tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	checkTx, _, _ := c.broadcast(tx)
+	return &ctypes.ResultBroadcastTx{
+		Code:      checkTx.Code,
+		Data:      checkTx.Data,
+		Log:       checkTx.Log,
+		Codespace: checkTx.Codespace,
+		Hash:      tx.Hash(),
+	}, nil
+}
+
+// broadcast checks the transaction against a copy of the state, a valid transaction
+// is then delivered and committed in a new block
+func (c *Chain) broadcast(tx tmtypes.Tx) (checkTx abci.ResponseCheckTx, deliverTx abci.ResponseDeliverTx, height int64) {
+	c.mtx.Lock()
+	check := c.runTx(c.state.clone(), tx, modeCheck)
+	checkTx = abci.ResponseCheckTx{
+		Code:      check.Code,
+		Log:       check.Log,
+		GasWanted: check.GasWanted,
+		GasUsed:   check.GasUsed,
+		Events:    check.Events,
+		Codespace: check.Codespace,
+	}
+	if checkTx.IsErr() {
+		c.mtx.Unlock()
+		return checkTx, deliverTx, 0
+	}
+
+	deliverTx = c.runTx(c.state, tx, modeDeliver)
+	b, err := c.commitBlock([]tmtypes.Tx{tx}, []*abci.ResponseDeliverTx{&deliverTx})
+	c.mtx.Unlock()
+	if err != nil {
+		panic(err)
+	}
+
+	c.publishBlock(b)
+	return checkTx, deliverTx, b.Height
+}
+
+// =============================================================================
+// SignClient
+
+func (c *Chain) Block(_ context.Context, height *int64) (*ctypes.ResultBlock, error) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	b, err := c.block(height)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultBlock{BlockID: b.id, Block: b.Block}, nil
+}
+
+func (c *Chain) BlockByHash(_ context.Context, hash []byte) (*ctypes.ResultBlock, error) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	for _, b := range c.blocks {
+		if tmbytes.HexBytes(hash).String() == b.id.Hash.String() {
+			return &ctypes.ResultBlock{BlockID: b.id, Block: b.Block}, nil
+		}
+	}
+	return &ctypes.ResultBlock{BlockID: tmtypes.BlockID{}, Block: nil}, nil
+}
+
+func (c *Chain) BlockResults(_ context.Context, height *int64) (*ctypes.ResultBlockResults, error) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	b, err := c.block(height)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultBlockResults{
+		Height:     b.Height,
+		TxsResults: b.results,
+	}, nil
+}
+
+func (c *Chain) Commit(_ context.Context, height *int64) (*ctypes.ResultCommit, error) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	b, err := c.block(height)
+	if err != nil {
+		return nil, err
+	}
+	return ctypes.NewResultCommit(&b.Header, b.commit, true), nil
+}
+
+func (c *Chain) Validators(_ context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	b, err := c.block(height)
+	if err != nil {
+		return nil, err
+	}
+
+	validators := c.validators.Validators
+	start, end, err := paginate(len(validators), page, perPage)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultValidators{
+		BlockHeight: b.Height,
+		Validators:  validators[start:end],
+		Count:       end - start,
+		Total:       len(validators),
+	}, nil
+}
+
+func (c *Chain) Tx(_ context.Context, hash []byte, _ bool) (*ctypes.ResultTx, error) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	res, ok := c.txs[tmbytes.HexBytes(hash).String()]
+	if !ok {
+		return nil, fmt.Errorf("tx (%X) not found", hash)
+	}
+	return res, nil
+}
+
+// TxSearch searches the transactions whose events match the query like the kv indexer of tendermint
+func (c *Chain) TxSearch(_ context.Context, q string, _ bool, page, perPage *int, orderBy string) (*ctypes.ResultTxSearch, error) {
+	parsed, err := query.New(q)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	var txs []*ctypes.ResultTx
+	for _, res := range c.txIndex {
+		matched, err := parsed.Matches(txEvents(res.Hash, res.Height, &res.TxResult))
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			txs = append(txs, res)
+		}
+	}
+
+	switch orderBy {
+	case "desc":
+		sort.SliceStable(txs, func(i, j int) bool { return txs[i].Height > txs[j].Height })
+	case "asc", "":
+	default:
+		return nil, fmt.Errorf("expected order_by to be either `asc` or `desc` or empty")
+	}
+
+	start, end, err := paginate(len(txs), page, perPage)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultTxSearch{
+		Txs:        txs[start:end],
+		TotalCount: len(txs),
+	}, nil
+}
+
+// =============================================================================
+// WSClient
+
+func (c *Chain) SubscribeNewBlock(builder *sdk.EventQueryBuilder, handler sdk.EventNewBlockHandler) (sdk.Subscription, sdk.Error) {
+	if builder == nil {
+		builder = sdk.NewEventQueryBuilder()
+	}
+	builder.AddCondition(sdk.Cond(sdk.TypeKey).EQ(tmtypes.EventNewBlock))
+	return c.subscribe(builder.Build(), func(data sdk.EventData) {
+		handler(data.(sdk.EventDataNewBlock))
+	})
+}
+
+func (c *Chain) SubscribeTx(builder *sdk.EventQueryBuilder, handler sdk.EventTxHandler) (sdk.Subscription, sdk.Error) {
+	if builder == nil {
+		builder = sdk.NewEventQueryBuilder()
+	}
+	builder.AddCondition(sdk.Cond(sdk.TypeKey).EQ(sdk.TxValue))
+	return c.subscribe(builder.Build(), func(data sdk.EventData) {
+		handler(data.(sdk.EventDataTx))
+	})
+}
+
+func (c *Chain) SubscribeNewBlockHeader(handler sdk.EventNewBlockHeaderHandler) (sdk.Subscription, sdk.Error) {
+	return c.subscribe(tmtypes.QueryForEvent(tmtypes.EventNewBlockHeader).String(), func(data sdk.EventData) {
+		handler(data.(sdk.EventDataNewBlockHeader))
+	})
+}
+
+// SubscribeValidatorSetUpdates never fires since the validator set of the chain is static
+func (c *Chain) SubscribeValidatorSetUpdates(handler sdk.EventValidatorSetUpdatesHandler) (sdk.Subscription, sdk.Error) {
+	return c.subscribe(tmtypes.QueryForEvent(tmtypes.EventValidatorSetUpdates).String(), func(data sdk.EventData) {
+		handler(data.(sdk.EventDataValidatorSetUpdates))
+	})
+}
+
+func (c *Chain) Unsubscribe(subscription sdk.Subscription) sdk.Error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if _, ok := c.subscribers[subscription.ID]; !ok {
+		return sdk.Wrapf("subscription %s not found", subscription.ID)
+	}
+	delete(c.subscribers, subscription.ID)
+	return nil
+}
+
+func (c *Chain) subscribe(q string, handler sdk.EventHandler) (sdk.Subscription, sdk.Error) {
+	parsed, err := query.New(q)
+	if err != nil {
+		return sdk.Subscription{}, sdk.Wrap(err)
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.nextSubID++
+	id := fmt.Sprintf("simchain-%d", c.nextSubID)
+	c.subscribers[id] = subscriber{query: parsed, handler: handler}
+	return sdk.Subscription{
+		Ctx:   context.Background(),
+		Query: q,
+		ID:    id,
+	}, nil
+}
+
+// publishBlock notifies the subscribers of the block and its transactions, the handlers
+// are called synchronously so that a test observes the events once the broadcast returns
+func (c *Chain) publishBlock(b *block) {
+	c.mtx.RLock()
+	subscribers := make([]subscriber, 0, len(c.subscribers))
+	ids := make([]string, 0, len(c.subscribers))
+	for id := range c.subscribers {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		subscribers = append(subscribers, c.subscribers[id])
+	}
+	c.mtx.RUnlock()
+
+	publish := func(events map[string][]string, data sdk.EventData) {
+		for _, sub := range subscribers {
+			if matched, err := sub.query.Matches(events); err == nil && matched {
+				sub.handler(data)
+			}
+		}
+	}
+
+	publish(
+		map[string][]string{tmtypes.EventTypeKey: {tmtypes.EventNewBlock}},
+		sdk.EventDataNewBlock{Block: sdk.ParseBlock(c.amino, b.Block)},
+	)
+	publish(
+		map[string][]string{tmtypes.EventTypeKey: {tmtypes.EventNewBlockHeader}},
+		sdk.EventDataNewBlockHeader{Header: b.Header},
+	)
+
+	for i, tx := range b.Txs {
+		decoded, err := c.encodingConfig.TxConfig.TxDecoder()(tx)
+		if err != nil {
+			continue
+		}
+
+		res := b.results[i]
+		hash := tmbytes.HexBytes(tx.Hash())
+		publish(txEvents(hash, b.Height, res), sdk.EventDataTx{
+			Hash:   hash.String(),
+			Height: b.Height,
+			Index:  uint32(i),
+			Tx:     decoded,
+			Result: sdk.TxResult{
+				Code:      res.Code,
+				Log:       res.Log,
+				GasWanted: res.GasWanted,
+				GasUsed:   res.GasUsed,
+				Events:    sdk.StringifyEvents(res.Events),
+			},
+		})
+	}
+}
+
+// =============================================================================
+// StatusClient and NetworkClient
+
+func (c *Chain) Status(context.Context) (*ctypes.ResultStatus, error) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	first, latest := c.blocks[0], c.latest()
+	validator := c.validators.Validators[0]
+	return &ctypes.ResultStatus{
+		NodeInfo: p2p.DefaultNodeInfo{
+			Network: c.chainID,
+			Moniker: defaultChainID,
+			Other:   p2p.DefaultNodeInfoOther{TxIndex: "on"},
+		},
+		SyncInfo: ctypes.SyncInfo{
+			LatestBlockHash:     latest.id.Hash,
+			LatestAppHash:       latest.AppHash,
+			LatestBlockHeight:   latest.Height,
+			LatestBlockTime:     latest.Time,
+			EarliestBlockHash:   first.id.Hash,
+			EarliestAppHash:     first.AppHash,
+			EarliestBlockHeight: first.Height,
+			EarliestBlockTime:   first.Time,
+		},
+		ValidatorInfo: ctypes.ValidatorInfo{
+			Address:     validator.Address,
+			PubKey:      validator.PubKey,
+			VotingPower: validator.VotingPower,
+		},
+	}, nil
+}
+
+func (c *Chain) NetInfo(context.Context) (*ctypes.ResultNetInfo, error) {
+	return &ctypes.ResultNetInfo{Listening: true}, nil
+}
+
+func (c *Chain) DumpConsensusState(context.Context) (*ctypes.ResultDumpConsensusState, error) {
+	return nil, fmt.Errorf("consensus state is not supported by %s", c.chainID)
+}
+
+func (c *Chain) ConsensusState(context.Context) (*ctypes.ResultConsensusState, error) {
+	return nil, fmt.Errorf("consensus state is not supported by %s", c.chainID)
+}
+
+func (c *Chain) ConsensusParams(_ context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	b, err := c.block(height)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultConsensusParams{
+		BlockHeight:     b.Height,
+		ConsensusParams: *tmtypes.DefaultConsensusParams(),
+	}, nil
+}
+
+func (c *Chain) Health(context.Context) (*ctypes.ResultHealth, error) {
+	return &ctypes.ResultHealth{}, nil
+}
+
+// =============================================================================
+// blocks
+
+func (c *Chain) latest() *block {
+	return c.blocks[len(c.blocks)-1]
+}
+
+// block returns the block at the given height, the latest one when height is nil
+func (c *Chain) block(height *int64) (*block, error) {
+	latest := c.latest()
+	if height == nil || *height == 0 {
+		return latest, nil
+	}
+
+	if *height < 0 {
+		return nil, fmt.Errorf("height must be greater than 0, but got %d", *height)
+	}
+	if *height > latest.Height {
+		return nil, fmt.Errorf("height %d must be less than or equal to the current blockchain height %d", *height, latest.Height)
+	}
+	return c.blocks[*height-1], nil
+}
+
+// commitBlock creates the next block with the executed transactions and signs its commit,
+// the caller must hold the write lock
+func (c *Chain) commitBlock(txs []tmtypes.Tx, results []*abci.ResponseDeliverTx) (*block, error) {
+	height := int64(len(c.blocks)) + 1
+	lastCommit := &tmtypes.Commit{}
+	var lastBlockID tmtypes.BlockID
+	var lastResults []*abci.ResponseDeliverTx
+	if height > 1 {
+		last := c.latest()
+		lastCommit, lastBlockID, lastResults = last.commit, last.id, last.results
+	}
+
+	validator := c.validators.Validators[0]
+	tmBlock := tmtypes.MakeBlock(height, txs, lastCommit, nil)
+	tmBlock.ChainID = c.chainID
+	tmBlock.Time = c.genesisTime.Add(time.Duration(height-1) * c.blockInterval)
+	tmBlock.LastBlockID = lastBlockID
+	tmBlock.ValidatorsHash = c.validators.Hash()
+	tmBlock.NextValidatorsHash = c.validators.Hash()
+	tmBlock.ConsensusHash = tmtypes.HashConsensusParams(*tmtypes.DefaultConsensusParams())
+	tmBlock.LastResultsHash = tmtypes.NewResults(lastResults).Hash()
+	tmBlock.ProposerAddress = validator.Address
+	// the app hash of a header is the state committed by the previous block
+	tmBlock.AppHash = c.state.hash()
+
+	partSet := tmBlock.MakePartSet(tmtypes.BlockPartSizeBytes)
+	blockID := tmtypes.BlockID{Hash: tmBlock.Hash(), PartSetHeader: partSet.Header()}
+
+	voteSet := tmtypes.NewVoteSet(c.chainID, height, 0, tmproto.PrecommitType, c.validators)
+	commit, err := tmtypes.MakeCommit(blockID, height, 0, voteSet, []tmtypes.PrivValidator{c.privVal}, tmBlock.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &block{
+		Block:   tmBlock,
+		id:      blockID,
+		commit:  commit,
+		results: results,
+	}
+	c.blocks = append(c.blocks, b)
+
+	for i, tx := range txs {
+		res := &ctypes.ResultTx{
+			Hash:     tx.Hash(),
+			Height:   height,
+			Index:    uint32(i),
+			TxResult: *results[i],
+			Tx:       tx,
+		}
+		c.txs[res.Hash.String()] = res
+		c.txIndex = append(c.txIndex, res)
+	}
+	return b, nil
+}
+
+// =============================================================================
+// helpers
+
+func defaultState() *state {
+	s := &state{
+		accounts:      make(map[string]auth.BaseAccount),
+		balances:      make(map[string]sdk.Coins),
+		tokens:        make(map[string]token.Token),
+		denomTraces:   make(map[string]transfer.DenomTrace),
+		rewards:       make(map[string]map[string]sdk.DecCoins),
+		commissions:   make(map[string]sdk.DecCoins),
+		slashes:       make(map[string][]slashEvent),
+		withdrawAddrs: make(map[string]string),
+		signingInfos:  make(map[string]slashing.ValidatorSigningInfo),
+		authParams: auth.Params{
+			MaxMemoCharacters:      256,
+			TxSigLimit:             7,
+			TxSizeCostPerByte:      10,
+			SigVerifyCostED25519:   590,
+			SigVerifyCostSecp256k1: 1000,
+		},
+		bankParams: bank.Params{DefaultSendEnabled: true},
+		tokenParams: token.Params{
+			TokenTaxRate:      sdk.NewDecWithPrec(4, 1),
+			IssueTokenBaseFee: sdk.NewCoin("iris", sdk.NewInt(60000)),
+			MintTokenFeeRatio: sdk.NewDecWithPrec(1, 1),
+		},
+		transferParams: transfer.Params{SendEnabled: true, ReceiveEnabled: true},
+		mintParams:     mint.Params{MintDenom: "uiris", Inflation: sdk.NewDecWithPrec(4, 2)},
+		minter:         mint.Minter{InflationBase: sdk.NewIntWithDecimal(2, 15)},
+		distrParams: distribution.Params{
+			CommunityTax:        sdk.NewDecWithPrec(2, 2),
+			BaseProposerReward:  sdk.NewDecWithPrec(1, 2),
+			BonusProposerReward: sdk.NewDecWithPrec(4, 2),
+			WithdrawAddrEnabled: true,
+		},
+		slashingParams: slashing.Params{
+			SignedBlocksWindow:      100,
+			MinSignedPerWindow:      sdk.NewDecWithPrec(5, 1),
+			DowntimeJailDuration:    10 * time.Minute,
+			SlashFractionDoubleSign: sdk.NewDecWithPrec(5, 2),
+			SlashFractionDowntime:   sdk.NewDecWithPrec(1, 2),
+		},
+	}
+
+	// the fee collector is the first module account of the chain
+	s.account(feeCollector, true)
+	s.tokens["iris"] = token.Token{
+		Symbol:        "iris",
+		Name:          "IRIS Network",
+		Scale:         6,
+		MinUnit:       "uiris",
+		InitialSupply: 2000000000,
+		MaxSupply:     10000000000,
+		Mintable:      true,
+	}
+	return s
+}
+
+// txEvents returns the indexed events of the transaction used to match the queries
+func txEvents(hash tmbytes.HexBytes, height int64, res *abci.ResponseDeliverTx) map[string][]string {
+	events := map[string][]string{
+		tmtypes.EventTypeKey: {tmtypes.EventTx},
+		tmtypes.TxHashKey:    {hash.String()},
+		tmtypes.TxHeightKey:  {fmt.Sprintf("%d", height)},
+	}
+	for _, event := range res.Events {
+		for _, attr := range event.Attributes {
+			key := fmt.Sprintf("%s.%s", event.Type, attr.Key)
+			events[key] = append(events[key], string(attr.Value))
+		}
+	}
+	return events
+}
+
+func transferEvents(sender, recipient string, amount sdk.Coins) []abci.Event {
+	return []abci.Event{
+		newEvent(
+			eventTypeTransfer,
+			attributeKeyRecipient, recipient,
+			sdk.AttributeKeySender, sender,
+			sdk.AttributeKeyAmount, amount.String(),
+		),
+		newEvent(sdk.EventTypeMessage, sdk.AttributeKeySender, sender),
+	}
+}
+
+// consAddressFromBech32 decodes the bech32 consensus address of a validator
+func consAddressFromBech32(address string) (sdk.ConsAddress, error) {
+	bz, err := sdk.GetFromBech32(address, sdk.GetAddrPrefixCfg().GetBech32ConsensusAddrPrefix())
+	if err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
+
+// newEvent creates an indexed event from the key value pairs of its attributes
+func newEvent(typ string, kvs ...string) abci.Event {
+	event := abci.Event{Type: typ}
+	for i := 0; i+1 < len(kvs); i += 2 {
+		event.Attributes = append(event.Attributes, abci.EventAttribute{
+			Key:   []byte(kvs[i]),
+			Value: []byte(kvs[i+1]),
+			Index: true,
+		})
+	}
+	return event
+}
+
+// paginate returns the bounds of the page like the rpc server of tendermint
+func paginate(total int, page, perPage *int) (int, int, error) {
+	size := defaultPerPage
+	if perPage != nil && *perPage > 0 {
+		size = *perPage
+	}
+	if size > maxPerPage {
+		size = maxPerPage
+	}
+
+	pages := (total + size - 1) / size
+	if pages == 0 {
+		pages = 1
+	}
+
+	p := 1
+	if page != nil {
+		p = *page
+	}
+	if p <= 0 || p > pages {
+		return 0, 0, fmt.Errorf("page should be within [1, %d] range, given %d", pages, p)
+	}
+
+	start := (p - 1) * size
+	end := start + size
+	if end > total {
+		end = total
+	}
+	return start, end, nil
+}
@@ -0,0 +1,437 @@
+package simchain_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/irisnet/irishub-sdk-go"
+	codectypes "github.com/irisnet/irishub-sdk-go/codec/types"
+	"github.com/irisnet/irishub-sdk-go/crypto"
+	"github.com/irisnet/irishub-sdk-go/crypto/keys/ed25519"
+	"github.com/irisnet/irishub-sdk-go/modules/auth"
+	"github.com/irisnet/irishub-sdk-go/modules/bank"
+	"github.com/irisnet/irishub-sdk-go/modules/distribution"
+	"github.com/irisnet/irishub-sdk-go/modules/mint"
+	"github.com/irisnet/irishub-sdk-go/modules/slashing"
+	"github.com/irisnet/irishub-sdk-go/modules/staking"
+	"github.com/irisnet/irishub-sdk-go/modules/token"
+	"github.com/irisnet/irishub-sdk-go/modules/transfer"
+	"github.com/irisnet/irishub-sdk-go/testutil/simchain"
+	"github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/store"
+)
+
+const (
+	name     = "alice"
+	password = "12345678"
+	to       = "iaa1qzds87rxyrv4ak9gr2mx9ptjhsqs5thcha0e5n"
+)
+
+func TestChain(t *testing.T) {
+	chain, err := simchain.New()
+	require.NoError(t, err)
+	defer func() { _ = chain.Close() }()
+
+	cfg, err := chain.ClientConfig(
+		types.KeyDAOOption(store.NewMemory(nil)),
+		types.ModeOption(types.Commit),
+	)
+	require.NoError(t, err)
+	client := sdk.NewIRISHUBClient(cfg)
+
+	from, _, err := client.Key.Add(name, password)
+	require.NoError(t, err)
+	require.NoError(t, chain.Fund(from, types.NewInt64Coin("uiris", 100000000)))
+
+	baseTx := types.BaseTx{From: name, Password: password}
+	amount, err := types.ParseDecCoins("10iris")
+	require.NoError(t, err)
+
+	var received []bank.EventDataMsgSend
+	client.Bank.SubscribeSendTx(from, to, func(data bank.EventDataMsgSend) {
+		received = append(received, data)
+	})
+
+	res, err := client.Bank.Send(to, amount, baseTx)
+	require.NoError(t, err)
+	require.Equal(t, chain.Height(), res.Height)
+	require.Len(t, received, 1)
+	require.Equal(t, res.Hash, received[0].Hash)
+
+	action, err := res.Events.GetValue("message", "action")
+	require.NoError(t, err)
+	require.Equal(t, "send", action)
+
+	// the fees are deducted from the sender
+	require.Equal(t, "86000000uiris", chain.Balances(from).String())
+	require.Equal(t, "10000000uiris", chain.Balances(to).String())
+
+	tx, err := client.QueryTx(res.Hash)
+	require.NoError(t, err)
+	require.Equal(t, res.Height, tx.Height)
+
+	account, err := client.Bank.QueryAccount(from)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), account.Sequence)
+
+	// the sender can not afford the transfer
+	amount, err = types.ParseDecCoins("100iris")
+	require.NoError(t, err)
+	_, err = client.Bank.Send(to, amount, baseTx)
+	require.Error(t, err)
+	require.Equal(t, uint32(types.InsufficientFunds), err.(types.Error).Code())
+
+	// the signer has no account
+	_, _, err = client.Key.Add("bob", password)
+	require.NoError(t, err)
+	_, err = client.Bank.Send(to, amount, types.BaseTx{From: "bob", Password: password})
+	require.Error(t, err)
+}
+
+func TestChainSm2(t *testing.T) {
+	chain, err := simchain.New()
+	require.NoError(t, err)
+	defer func() { _ = chain.Close() }()
+
+	cfg, err := chain.ClientConfig(
+		types.KeyDAOOption(store.NewMemory(nil)),
+		types.ModeOption(types.Commit),
+	)
+	require.NoError(t, err)
+	client := sdk.NewIRISHUBClient(cfg)
+
+	from, _, err := client.Key.Add(name, password, types.KeyAlgoOption("sm2"), types.KeyIndexOption(1))
+	require.NoError(t, err)
+	require.NoError(t, chain.Fund(from, types.NewInt64Coin("uiris", 100000000)))
+
+	info, err := client.Key.ShowInfo(name)
+	require.NoError(t, err)
+	require.Equal(t, "sm2", info.Algo)
+	require.Equal(t, "44'/118'/0'/0/1", info.HDPath)
+	require.Equal(t, from, info.Address)
+
+	amount, err := types.ParseDecCoins("10iris")
+	require.NoError(t, err)
+	_, err = client.Bank.Send(to, amount, types.BaseTx{From: name, Password: password})
+	require.NoError(t, err)
+	require.Equal(t, "10000000uiris", chain.Balances(to).String())
+}
+
+func TestChainPublicKeys(t *testing.T) {
+	chain, err := simchain.New()
+	require.NoError(t, err)
+	defer func() { _ = chain.Close() }()
+
+	cfg, err := chain.ClientConfig(
+		types.KeyDAOOption(store.NewMemory(nil)),
+		types.ModeOption(types.Commit),
+	)
+	require.NoError(t, err)
+	client := sdk.NewIRISHUBClient(cfg)
+
+	var pubKeys []types.TmPubKey
+	for _, algo := range []string{"secp256k1", "sm2", "secp256k1"} {
+		km, err := crypto.NewAlgoKeyManager(algo)
+		require.NoError(t, err)
+		pubKeys = append(pubKeys, km.ExportPubKey())
+	}
+
+	watchAddress := types.AccAddress(pubKeys[0].Address()).String()
+	_, err = client.Key.ImportWatchOnly("watch", password, to, pubKeys[0])
+	require.Error(t, err)
+	address, err := client.Key.ImportWatchOnly("watch", password, watchAddress, pubKeys[0])
+	require.NoError(t, err)
+	require.Equal(t, watchAddress, address)
+
+	multiAddress, err := client.Key.ImportMultisig("multi", password, 2, pubKeys)
+	require.NoError(t, err)
+	_, err = client.Key.ImportMultisig("multi2", password, 4, pubKeys)
+	require.Error(t, err)
+
+	infos, err := client.Key.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+	require.Equal(t, "multi", infos[0].Type)
+	require.Equal(t, multiAddress, infos[0].Address)
+	require.Equal(t, uint32(2), infos[0].Threshold)
+	require.Len(t, infos[0].PubKeys, 3)
+	require.Equal(t, "offline", infos[1].Type)
+	require.Equal(t, watchAddress, infos[1].Address)
+
+	amount := types.NewCoins(types.NewInt64Coin("uiris", 1000000))
+	for _, from := range []string{watchAddress, multiAddress} {
+		require.NoError(t, chain.Fund(from, types.NewInt64Coin("uiris", 100000000)))
+	}
+
+	for name, from := range map[string]string{"watch": watchAddress, "multi": multiAddress} {
+		fromAddr, e := types.AccAddressFromBech32(from)
+		require.NoError(t, e)
+		toAddr, e := types.AccAddressFromBech32(to)
+		require.NoError(t, e)
+
+		bz, err := client.BuildUnsignedTx(
+			[]types.Msg{bank.NewMsgSend(fromAddr, toAddr, amount)},
+			types.BaseTx{From: name, Memo: "unsigned"},
+		)
+		require.NoError(t, err)
+		require.Contains(t, string(bz), from)
+		require.Contains(t, string(bz), `"signatures":[]`)
+
+		// the keys can not sign
+		_, err = client.Bank.Send(to, types.NewDecCoinsFromCoins(amount...), types.BaseTx{From: name})
+		require.Error(t, err)
+	}
+}
+
+func TestChainBank(t *testing.T) {
+	coins := []types.Coin{
+		types.NewInt64Coin("uiris", 3000000),
+		types.NewInt64Coin("swap/utoken", 100),
+	}
+	for i := 0; i < 150; i++ {
+		coins = append(coins, types.NewInt64Coin(fmt.Sprintf("nft%03d", i), 1))
+	}
+
+	_, client := newClient(t, simchain.BalanceOption(to, coins...))
+
+	// the balances are more than a page
+	account, err := client.Bank.QueryAccount(to)
+	require.NoError(t, err)
+	require.Len(t, account.Coins, len(coins))
+	require.Equal(t, types.NewCoins(coins...), account.Coins)
+
+	balance, err := client.Bank.QueryBalance(to, "iris")
+	require.NoError(t, err)
+	require.Equal(t, "3.000000000000000000iris", balance.String())
+
+	balance, err = client.Bank.QueryBalance(to, "uiris")
+	require.NoError(t, err)
+	require.Equal(t, "3.000000000000000000iris", balance.String())
+
+	// the liquidity is not a token and kept in the min unit
+	balance, err = client.Bank.QueryBalance(to, "swap/utoken")
+	require.NoError(t, err)
+	require.Equal(t, "100.000000000000000000swap/utoken", balance.String())
+
+	supply, err := client.Bank.QuerySupplyOf("iris")
+	require.NoError(t, err)
+	require.Equal(t, "3.000000000000000000iris", supply.String())
+
+	total, err := client.Bank.QueryTotalSupply()
+	require.NoError(t, err)
+	require.Len(t, total, len(coins))
+	require.Equal(t, types.NewDec(3), total.AmountOf("iris"))
+	require.Equal(t, types.NewDec(1), total.AmountOf("nft149"))
+
+	params, err := client.Bank.QueryParams()
+	require.NoError(t, err)
+	require.True(t, params.DefaultSendEnabled)
+}
+
+func TestChainAuth(t *testing.T) {
+	_, client := newClient(t, simchain.BalanceOption(to, types.NewInt64Coin("uiris", 3000000)))
+
+	account, err := client.Auth.QueryAccount(to)
+	require.NoError(t, err)
+	require.Equal(t, auth.BaseAccountType, account.Type)
+	require.Equal(t, to, account.Address)
+	require.Nil(t, account.Vesting)
+	require.True(t, account.Locked.Empty())
+	require.Equal(t, types.NewCoins(types.NewInt64Coin("uiris", 3000000)), account.Spendable)
+
+	params, err := client.Auth.QueryParams()
+	require.NoError(t, err)
+	require.Equal(t, uint64(256), params.MaxMemoCharacters)
+	require.Equal(t, uint64(1000), params.SigVerifyCostSecp256k1)
+}
+
+func TestChainParams(t *testing.T) {
+	chain, client := newClient(t)
+
+	var res bank.QueryParamsResponse
+	require.NoError(t, client.QueryParams(bank.ModuleName, &res))
+	require.True(t, res.Params.DefaultSendEnabled)
+
+	require.Error(t, client.QueryParams(auth.ModuleName, &res))
+	require.Error(t, client.QueryParams("unknown", &res))
+
+	// the params of the modules not served by the chain are skipped
+	chainParams, err := client.QueryChainParams(0)
+	require.NoError(t, err)
+	require.Equal(t, chain.Height(), chainParams.Height)
+	require.Len(t, chainParams.Params, 7)
+	require.Equal(t, "uiris", chainParams.Params[mint.ModuleName].(mint.QueryParamsResp).MintDenom)
+	require.Equal(t, types.NewDecWithPrec(2, 2), chainParams.Params[distribution.ModuleName].(distribution.QueryParamsResp).CommunityTax)
+	require.Equal(t, uint64(256), chainParams.Params[auth.ModuleName].(auth.QueryParamsResp).MaxMemoCharacters)
+	require.Equal(t, int64(100), chainParams.Params[slashing.ModuleName].(slashing.QueryParamsResp).SignedBlocksWindow)
+
+	changes, err := client.DiffChainParams(chainParams.Height, 0)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	// the gateway answers the queries of the modules not served by the chain with a plain 404
+	cfg, e := chain.RESTClientConfig(types.KeyDAOOption(store.NewMemory(nil)))
+	require.NoError(t, e)
+	restClient := sdk.NewIRISHUBClient(cfg)
+
+	restParams, err := restClient.QueryChainParams(0)
+	require.NoError(t, err)
+	require.Equal(t, chainParams, restParams)
+}
+
+func TestChainTransfer(t *testing.T) {
+	atomTrace := transfer.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}
+	unknownTrace := transfer.DenomTrace{Path: "transfer/channel-1/transfer/channel-7", BaseDenom: "uosmo"}
+	atomDenom := types.DenomTrace{Path: atomTrace.Path, BaseDenom: atomTrace.BaseDenom}.IBCDenom()
+	unknownDenom := types.DenomTrace{Path: unknownTrace.Path, BaseDenom: unknownTrace.BaseDenom}.IBCDenom()
+
+	_, client := newClient(t,
+		simchain.TokenOption(token.Token{Symbol: "atom", Name: "Cosmos Hub", Scale: 6, MinUnit: "uatom"}),
+		simchain.DenomTraceOption(atomTrace, unknownTrace),
+		simchain.BalanceOption(to, types.NewInt64Coin(atomDenom, 2500000), types.NewInt64Coin(unknownDenom, 7)),
+	)
+
+	var err error
+	trace, err := client.Transfer.QueryDenomTrace(atomDenom)
+	require.NoError(t, err)
+	require.Equal(t, "transfer/channel-0/uatom", trace.FullPath())
+	require.Equal(t, atomDenom, trace.IBCDenom())
+
+	// the hash alone or in lower case resolves the same trace
+	hash, err := types.ParseIBCDenomHash(atomDenom)
+	require.NoError(t, err)
+	trace, err = client.Transfer.QueryDenomTrace(strings.ToLower(hash.String()))
+	require.NoError(t, err)
+	require.Equal(t, "uatom", trace.BaseDenom)
+
+	traces, err := client.Transfer.QueryDenomTraces(1, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), traces.Total)
+
+	// the vouchers keep their denom in the main unit with the scale of their base denom
+	atom, err := client.QueryToken(atomDenom)
+	require.NoError(t, err)
+	require.Equal(t, atomDenom, atom.Symbol)
+	require.Equal(t, atomDenom, atom.MinUnit)
+	require.Equal(t, uint32(6), atom.Scale)
+
+	vouchers := types.NewCoins(types.NewInt64Coin(atomDenom, 2500000), types.NewInt64Coin(unknownDenom, 7))
+	coins, err := client.ToMainCoin(vouchers...)
+	require.NoError(t, err)
+	require.Equal(t, "2.500000000000000000", coins.AmountOf(atomDenom).String())
+	require.Equal(t, "7.000000000000000000", coins.AmountOf(unknownDenom).String())
+
+	// the main coins convert back to the vouchers, the decimals are not truncated
+	minCoins, err := client.ToMinCoin(coins...)
+	require.NoError(t, err)
+	require.Equal(t, vouchers, minCoins)
+
+	minCoins, err = client.ToMinCoin(types.NewDecCoinFromDec(atomDenom, types.NewDecWithPrec(15, 1)))
+	require.NoError(t, err)
+	require.Equal(t, "1500000"+atomDenom, minCoins.String())
+
+	// the native token keeps its min unit and its main coins stay distinct from the vouchers
+	native, err := client.QueryToken("atom")
+	require.NoError(t, err)
+	require.Equal(t, "uatom", native.MinUnit)
+
+	coins, err = client.ToMainCoin(types.NewInt64Coin("uatom", 1000000), types.NewInt64Coin(atomDenom, 2500000))
+	require.NoError(t, err)
+	require.Len(t, coins, 2)
+
+	_, err = client.QueryToken("ibc/" + strings.Repeat("0", 64))
+	require.Error(t, err)
+
+	params, err := client.Transfer.QueryParams()
+	require.NoError(t, err)
+	require.True(t, params.SendEnabled)
+}
+
+// newClient returns a new chain of the options and a client of the chain keeping its keys in memory,
+// the chain is closed when the test finishes
+func newClient(t *testing.T, options ...simchain.Option) (*simchain.Chain, sdk.IRISHUBClient) {
+	t.Helper()
+
+	chain, err := simchain.New(options...)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = chain.Close() })
+
+	cfg, err := chain.ClientConfig(types.KeyDAOOption(store.NewMemory(nil)))
+	require.NoError(t, err)
+	return chain, sdk.NewIRISHUBClient(cfg)
+}
+
+func TestChainStakingAPR(t *testing.T) {
+	_, client := newClient(t,
+		simchain.MinterOption(types.NewInt(2000000)),
+		simchain.BalanceOption(to, types.NewInt64Coin("uiris", 500000)),
+		simchain.ValidatorOption(
+			validator("alpha", staking.Bonded, 300000, types.NewDecWithPrec(1, 1)),
+			validator("beta", staking.Bonded, 200000, types.OneDec()),
+			validator("gamma", staking.Unbonding, 100000, types.ZeroDec()),
+		),
+	)
+
+	inflation, err := client.Mint.QueryInflation()
+	require.NoError(t, err)
+	require.Equal(t, types.NewDecWithPrec(4, 2), inflation)
+
+	// the annual provisions are the inflation of the inflation base of the minter, not of the supply
+	annualProvisions, err := client.Mint.QueryAnnualProvisions()
+	require.NoError(t, err)
+	require.Equal(t, types.NewDec(80000), annualProvisions)
+
+	// 2% of the rewards go to the community pool and only the bonded validators are estimated
+	apr, err := client.EstimateStakingAPR()
+	require.NoError(t, err)
+	require.Equal(t, types.NewInt(500000), apr.BondedTokens)
+	require.Equal(t, types.MustNewDecFromStr("0.1568"), apr.APR)
+	require.Len(t, apr.Validators, 2)
+
+	aprs := make(map[string]types.Dec)
+	for _, v := range apr.Validators {
+		aprs[v.Moniker] = v.APR
+	}
+	require.Equal(t, types.MustNewDecFromStr("0.14112"), aprs["alpha"])
+	require.True(t, aprs["beta"].IsZero())
+
+	// the operator address and the consensus pubkey of the validators are required
+	invalid := validator("delta", staking.Bonded, 1, types.ZeroDec())
+	invalid.OperatorAddress = to
+	_, e := simchain.New(simchain.ValidatorOption(invalid))
+	require.Error(t, e)
+
+	invalid = validator("delta", staking.Bonded, 1, types.ZeroDec())
+	invalid.ConsensusPubkey = nil
+	_, e = simchain.New(simchain.ValidatorOption(invalid))
+	require.Error(t, e)
+
+	_, e = simchain.New(simchain.MinterOption(types.NewInt(-1)))
+	require.Error(t, e)
+}
+
+// validator returns a validator of the staking queries with the given tokens and commission rate
+func validator(moniker string, status staking.BondStatus, tokens int64, commission types.Dec) staking.Validator {
+	pubKey, err := codectypes.NewAnyWithValue(ed25519.GenPrivKeyFromSecret([]byte(moniker)).PubKey().(*ed25519.PubKey))
+	if err != nil {
+		panic(err)
+	}
+
+	v := staking.Validator{
+		OperatorAddress:   types.ValAddress(tmcrypto.AddressHash([]byte(moniker))).String(),
+		ConsensusPubkey:   pubKey,
+		Status:            status,
+		Tokens:            types.NewInt(tokens),
+		DelegatorShares:   types.NewDec(tokens),
+		Description:       staking.Description{Moniker: moniker},
+		MinSelfDelegation: types.OneInt(),
+	}
+	v.Commission.Rate = commission
+	v.Commission.MaxRate = types.OneDec()
+	v.Commission.MaxChangeRate = types.NewDecWithPrec(1, 2)
+	return v
+}
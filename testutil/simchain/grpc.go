@@ -0,0 +1,621 @@
+package simchain
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	cdctypes "github.com/irisnet/irishub-sdk-go/codec/types"
+	cryptocodec "github.com/irisnet/irishub-sdk-go/crypto/codec"
+	"github.com/irisnet/irishub-sdk-go/modules/auth"
+	"github.com/irisnet/irishub-sdk-go/modules/bank"
+	"github.com/irisnet/irishub-sdk-go/modules/coinswap"
+	"github.com/irisnet/irishub-sdk-go/modules/distribution"
+	"github.com/irisnet/irishub-sdk-go/modules/gov"
+	"github.com/irisnet/irishub-sdk-go/modules/htlc"
+	"github.com/irisnet/irishub-sdk-go/modules/mint"
+	"github.com/irisnet/irishub-sdk-go/modules/nft"
+	"github.com/irisnet/irishub-sdk-go/modules/oracle"
+	"github.com/irisnet/irishub-sdk-go/modules/params"
+	"github.com/irisnet/irishub-sdk-go/modules/random"
+	"github.com/irisnet/irishub-sdk-go/modules/record"
+	"github.com/irisnet/irishub-sdk-go/modules/service"
+	"github.com/irisnet/irishub-sdk-go/modules/slashing"
+	"github.com/irisnet/irishub-sdk-go/modules/staking"
+	"github.com/irisnet/irishub-sdk-go/modules/token"
+	"github.com/irisnet/irishub-sdk-go/modules/transfer"
+	"github.com/irisnet/irishub-sdk-go/modules/upgrade"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/query"
+	txtypes "github.com/irisnet/irishub-sdk-go/types/tx"
+)
+
+const (
+	// bufnet is the address of the in-process grpc server
+	bufnet     = "bufnet"
+	bufferSize = 1024 * 1024
+	// defaultLimit is the page size of the paginated queries when no limit is given
+	defaultLimit = 100
+)
+
+// GenConn dials the in-process grpc server of the chain
+func (c *Chain) GenConn() (sdk.QueryConn, error) {
+	return grpc.Dial(bufnet,
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return c.listener.Dial()
+		}),
+		grpc.WithInsecure(),
+	)
+}
+
+// startGRPCServer serves the auth, bank, token, transfer, mint, distribution and slashing queries and the pool and validators
+// of staking from the state of the chain. The other queries return codes.Unimplemented,
+// the ones of the other modules are not routed by the REST gateway
+func (c *Chain) startGRPCServer() {
+	c.listener = bufconn.Listen(bufferSize)
+	c.server = grpc.NewServer()
+
+	auth.RegisterQueryServer(c.server, authServer{c})
+	bank.RegisterQueryServer(c.server, bankServer{c})
+	token.RegisterQueryServer(c.server, tokenServer{c})
+	transfer.RegisterQueryServer(c.server, transferServer{c})
+	mint.RegisterQueryServer(c.server, mintServer{c})
+	staking.RegisterQueryServer(c.server, &stakingServer{c: c})
+	distribution.RegisterQueryServer(c.server, &distributionServer{c: c})
+	slashing.RegisterQueryServer(c.server, slashingServer{c})
+	c.restServices = c.server.GetServiceInfo()
+
+	coinswap.RegisterQueryServer(c.server, &coinswap.UnimplementedQueryServer{})
+	gov.RegisterQueryServer(c.server, &gov.UnimplementedQueryServer{})
+	htlc.RegisterQueryServer(c.server, &htlc.UnimplementedQueryServer{})
+	nft.RegisterQueryServer(c.server, &nft.UnimplementedQueryServer{})
+	oracle.RegisterQueryServer(c.server, &oracle.UnimplementedQueryServer{})
+	random.RegisterQueryServer(c.server, &random.UnimplementedQueryServer{})
+	record.RegisterQueryServer(c.server, &record.UnimplementedQueryServer{})
+	service.RegisterQueryServer(c.server, &service.UnimplementedQueryServer{})
+	upgrade.RegisterQueryServer(c.server, &upgrade.UnimplementedQueryServer{})
+
+	go func() { _ = c.server.Serve(c.listener) }()
+}
+
+type authServer struct {
+	c *Chain
+}
+
+func (a authServer) Account(_ context.Context, req *auth.QueryAccountRequest) (*auth.QueryAccountResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	a.c.mtx.RLock()
+	defer a.c.mtx.RUnlock()
+
+	acc, ok := a.c.state.account(req.Address, false)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", req.Address)
+	}
+
+	any, err := cdctypes.NewAnyWithValue(&acc)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &auth.QueryAccountResponse{Account: any}, nil
+}
+
+func (a authServer) Params(context.Context, *auth.QueryParamsRequest) (*auth.QueryParamsResponse, error) {
+	a.c.mtx.RLock()
+	defer a.c.mtx.RUnlock()
+	return &auth.QueryParamsResponse{Params: a.c.state.authParams}, nil
+}
+
+type bankServer struct {
+	c *Chain
+}
+
+func (b bankServer) Balance(_ context.Context, req *bank.QueryBalanceRequest) (*bank.QueryBalanceResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	b.c.mtx.RLock()
+	defer b.c.mtx.RUnlock()
+
+	balance := sdk.NewCoin(req.Denom, b.c.state.balances[req.Address].AmountOf(req.Denom))
+	return &bank.QueryBalanceResponse{Balance: &balance}, nil
+}
+
+func (b bankServer) AllBalances(_ context.Context, req *bank.QueryAllBalancesRequest) (*bank.QueryAllBalancesResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	b.c.mtx.RLock()
+	defer b.c.mtx.RUnlock()
+
+	balances := b.c.state.balances[req.Address]
+	start, end, pageRes, err := paginateCoins(len(balances), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &bank.QueryAllBalancesResponse{
+		Balances:   balances[start:end],
+		Pagination: pageRes,
+	}, nil
+}
+
+func (b bankServer) TotalSupply(context.Context, *bank.QueryTotalSupplyRequest) (*bank.QueryTotalSupplyResponse, error) {
+	b.c.mtx.RLock()
+	defer b.c.mtx.RUnlock()
+	return &bank.QueryTotalSupplyResponse{Supply: b.c.state.supply()}, nil
+}
+
+func (b bankServer) SupplyOf(_ context.Context, req *bank.QuerySupplyOfRequest) (*bank.QuerySupplyOfResponse, error) {
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	b.c.mtx.RLock()
+	defer b.c.mtx.RUnlock()
+	return &bank.QuerySupplyOfResponse{
+		Amount: sdk.NewCoin(req.Denom, b.c.state.supply().AmountOf(req.Denom)),
+	}, nil
+}
+
+func (b bankServer) Params(context.Context, *bank.QueryParamsRequest) (*bank.QueryParamsResponse, error) {
+	b.c.mtx.RLock()
+	defer b.c.mtx.RUnlock()
+	return &bank.QueryParamsResponse{Params: b.c.state.bankParams}, nil
+}
+
+type tokenServer struct {
+	c *Chain
+}
+
+func (t tokenServer) Token(_ context.Context, req *token.QueryTokenRequest) (*token.QueryTokenResponse, error) {
+	t.c.mtx.RLock()
+	defer t.c.mtx.RUnlock()
+
+	tk, ok := t.c.state.token(strings.ToLower(req.Denom))
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "token %s does not exist", req.Denom)
+	}
+
+	any, err := cdctypes.NewAnyWithValue(&tk)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &token.QueryTokenResponse{Token: any}, nil
+}
+
+func (t tokenServer) Tokens(_ context.Context, req *token.QueryTokensRequest) (*token.QueryTokensResponse, error) {
+	t.c.mtx.RLock()
+	defer t.c.mtx.RUnlock()
+
+	symbols := make([]string, 0, len(t.c.state.tokens))
+	for symbol, tk := range t.c.state.tokens {
+		if len(req.Owner) == 0 || tk.Owner == req.Owner {
+			symbols = append(symbols, symbol)
+		}
+	}
+	sort.Strings(symbols)
+
+	tokens := make([]*cdctypes.Any, len(symbols))
+	for i, symbol := range symbols {
+		tk := t.c.state.tokens[symbol]
+		any, err := cdctypes.NewAnyWithValue(&tk)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		tokens[i] = any
+	}
+	return &token.QueryTokensResponse{Tokens: tokens}, nil
+}
+
+// Fees returns the base issue fee in the min unit of iris, the mint fee is charged
+// proportionally to the issue fee
+func (t tokenServer) Fees(_ context.Context, req *token.QueryFeesRequest) (*token.QueryFeesResponse, error) {
+	t.c.mtx.RLock()
+	defer t.c.mtx.RUnlock()
+
+	params := t.c.state.tokenParams
+	_, exist := t.c.state.tokens[strings.ToLower(req.Symbol)]
+
+	native, _ := t.c.state.token(params.IssueTokenBaseFee.Denom)
+	issueFee, err := native.Convert().(sdk.Token).GetCoinType().ConvertToMinCoin(sdk.NewDecCoinFromCoin(params.IssueTokenBaseFee))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	mintFee := sdk.NewCoin(issueFee.Denom, params.MintTokenFeeRatio.MulInt(issueFee.Amount).TruncateInt())
+
+	return &token.QueryFeesResponse{
+		Exist:    exist,
+		IssueFee: issueFee,
+		MintFee:  mintFee,
+	}, nil
+}
+
+func (t tokenServer) Params(context.Context, *token.QueryParamsRequest) (*token.QueryParamsResponse, error) {
+	t.c.mtx.RLock()
+	defer t.c.mtx.RUnlock()
+	return &token.QueryParamsResponse{Params: t.c.state.tokenParams}, nil
+}
+
+type transferServer struct {
+	c *Chain
+}
+
+func (t transferServer) DenomTrace(_ context.Context, req *transfer.QueryDenomTraceRequest) (*transfer.QueryDenomTraceResponse, error) {
+	hash, err := sdk.ParseIBCDenomHash(req.Hash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	t.c.mtx.RLock()
+	defer t.c.mtx.RUnlock()
+
+	trace, ok := t.c.state.denomTraces[hash.String()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "denomination trace not found for %s", req.Hash)
+	}
+	return &transfer.QueryDenomTraceResponse{DenomTrace: &trace}, nil
+}
+
+func (t transferServer) DenomTraces(_ context.Context, req *transfer.QueryDenomTracesRequest) (*transfer.QueryDenomTracesResponse, error) {
+	t.c.mtx.RLock()
+	defer t.c.mtx.RUnlock()
+
+	hashes := make([]string, 0, len(t.c.state.denomTraces))
+	for hash := range t.c.state.denomTraces {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+
+	start, end, pageRes, err := paginateCoins(len(hashes), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := make(transfer.Traces, 0, end-start)
+	for _, hash := range hashes[start:end] {
+		traces = append(traces, t.c.state.denomTraces[hash])
+	}
+	return &transfer.QueryDenomTracesResponse{DenomTraces: traces, Pagination: pageRes}, nil
+}
+
+func (t transferServer) Params(context.Context, *transfer.QueryParamsRequest) (*transfer.QueryParamsResponse, error) {
+	t.c.mtx.RLock()
+	defer t.c.mtx.RUnlock()
+
+	params := t.c.state.transferParams
+	return &transfer.QueryParamsResponse{Params: &params}, nil
+}
+
+type mintServer struct {
+	c *Chain
+}
+
+func (m mintServer) Params(context.Context, *mint.QueryParamsRequest) (*mint.QueryParamsResponse, error) {
+	m.c.mtx.RLock()
+	defer m.c.mtx.RUnlock()
+	return &mint.QueryParamsResponse{Params: m.c.state.mintParams}, nil
+}
+
+type stakingServer struct {
+	staking.UnimplementedQueryServer
+	c *Chain
+}
+
+func (s *stakingServer) Validators(_ context.Context, req *staking.QueryValidatorsRequest) (*staking.QueryValidatorsResponse, error) {
+	if _, ok := staking.BondStatus_value[req.Status]; !ok && len(req.Status) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator status %s", req.Status)
+	}
+
+	s.c.mtx.RLock()
+	defer s.c.mtx.RUnlock()
+
+	var validators []staking.Validator
+	for _, v := range s.c.state.validators {
+		if len(req.Status) == 0 || v.Status.String() == req.Status {
+			validators = append(validators, v)
+		}
+	}
+
+	start, end, pageRes, err := paginateCoins(len(validators), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &staking.QueryValidatorsResponse{
+		Validators: validators[start:end],
+		Pagination: pageRes,
+	}, nil
+}
+
+func (s *stakingServer) Pool(context.Context, *staking.QueryPoolRequest) (*staking.QueryPoolResponse, error) {
+	s.c.mtx.RLock()
+	defer s.c.mtx.RUnlock()
+
+	pool := staking.Pool{NotBondedTokens: sdk.ZeroInt(), BondedTokens: sdk.ZeroInt()}
+	for _, v := range s.c.state.validators {
+		if v.Status == staking.Bonded {
+			pool.BondedTokens = pool.BondedTokens.Add(v.Tokens)
+		} else {
+			pool.NotBondedTokens = pool.NotBondedTokens.Add(v.Tokens)
+		}
+	}
+	return &staking.QueryPoolResponse{Pool: pool}, nil
+}
+
+type distributionServer struct {
+	distribution.UnimplementedQueryServer
+	c *Chain
+}
+
+func (d *distributionServer) Params(context.Context, *distribution.QueryParamsRequest) (*distribution.QueryParamsResponse, error) {
+	d.c.mtx.RLock()
+	defer d.c.mtx.RUnlock()
+	return &distribution.QueryParamsResponse{Params: d.c.state.distrParams}, nil
+}
+
+func (d *distributionServer) ValidatorOutstandingRewards(_ context.Context, req *distribution.QueryValidatorOutstandingRewardsRequest) (*distribution.QueryValidatorOutstandingRewardsResponse, error) {
+	if _, err := sdk.ValAddressFromBech32(req.ValidatorAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	d.c.mtx.RLock()
+	defer d.c.mtx.RUnlock()
+
+	rewards := distribution.ValidatorOutstandingRewards{Rewards: d.c.state.outstandingRewards(req.ValidatorAddress)}
+	return &distribution.QueryValidatorOutstandingRewardsResponse{Rewards: rewards}, nil
+}
+
+func (d *distributionServer) ValidatorCommission(_ context.Context, req *distribution.QueryValidatorCommissionRequest) (*distribution.QueryValidatorCommissionResponse, error) {
+	if _, err := sdk.ValAddressFromBech32(req.ValidatorAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	d.c.mtx.RLock()
+	defer d.c.mtx.RUnlock()
+
+	commission := distribution.ValidatorAccumulatedCommission{Commission: d.c.state.commissions[req.ValidatorAddress]}
+	return &distribution.QueryValidatorCommissionResponse{Commission: commission}, nil
+}
+
+func (d *distributionServer) ValidatorSlashes(_ context.Context, req *distribution.QueryValidatorSlashesRequest) (*distribution.QueryValidatorSlashesResponse, error) {
+	if _, err := sdk.ValAddressFromBech32(req.ValidatorAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.EndingHeight < req.StartingHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "starting height greater than ending height (%d > %d)", req.StartingHeight, req.EndingHeight)
+	}
+
+	d.c.mtx.RLock()
+	defer d.c.mtx.RUnlock()
+
+	var slashes []slashEvent
+	for _, slash := range d.c.state.slashes[req.ValidatorAddress] {
+		if slash.height >= req.StartingHeight && slash.height <= req.EndingHeight {
+			slashes = append(slashes, slash)
+		}
+	}
+	sort.SliceStable(slashes, func(i, j int) bool { return slashes[i].height < slashes[j].height })
+
+	start, end, pageRes, err := paginateCoins(len(slashes), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]distribution.ValidatorSlashEvent, 0, end-start)
+	for _, slash := range slashes[start:end] {
+		events = append(events, slash.event)
+	}
+	return &distribution.QueryValidatorSlashesResponse{Slashes: events, Pagination: pageRes}, nil
+}
+
+func (d *distributionServer) DelegationRewards(_ context.Context, req *distribution.QueryDelegationRewardsRequest) (*distribution.QueryDelegationRewardsResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(req.DelegatorAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, err := sdk.ValAddressFromBech32(req.ValidatorAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	d.c.mtx.RLock()
+	defer d.c.mtx.RUnlock()
+
+	rewards, ok := d.c.state.rewards[req.DelegatorAddress][req.ValidatorAddress]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "delegation of %s to %s does not exist", req.DelegatorAddress, req.ValidatorAddress)
+	}
+	return &distribution.QueryDelegationRewardsResponse{Rewards: rewards}, nil
+}
+
+func (d *distributionServer) DelegationTotalRewards(_ context.Context, req *distribution.QueryDelegationTotalRewardsRequest) (*distribution.QueryDelegationTotalRewardsResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(req.DelegatorAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	d.c.mtx.RLock()
+	defer d.c.mtx.RUnlock()
+
+	res := &distribution.QueryDelegationTotalRewardsResponse{}
+	for _, validator := range d.c.state.delegatorValidators(req.DelegatorAddress) {
+		rewards := d.c.state.rewards[req.DelegatorAddress][validator]
+		res.Rewards = append(res.Rewards, distribution.DelegationDelegatorReward{
+			ValidatorAddress: validator,
+			Reward:           rewards,
+		})
+		res.Total = res.Total.Add(rewards...)
+	}
+	return res, nil
+}
+
+func (d *distributionServer) DelegatorValidators(_ context.Context, req *distribution.QueryDelegatorValidatorsRequest) (*distribution.QueryDelegatorValidatorsResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(req.DelegatorAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	d.c.mtx.RLock()
+	defer d.c.mtx.RUnlock()
+	return &distribution.QueryDelegatorValidatorsResponse{Validators: d.c.state.delegatorValidators(req.DelegatorAddress)}, nil
+}
+
+func (d *distributionServer) DelegatorWithdrawAddress(_ context.Context, req *distribution.QueryDelegatorWithdrawAddressRequest) (*distribution.QueryDelegatorWithdrawAddressResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(req.DelegatorAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	d.c.mtx.RLock()
+	defer d.c.mtx.RUnlock()
+	return &distribution.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: d.c.state.withdrawAddr(req.DelegatorAddress)}, nil
+}
+
+func (d *distributionServer) CommunityPool(context.Context, *distribution.QueryCommunityPoolRequest) (*distribution.QueryCommunityPoolResponse, error) {
+	d.c.mtx.RLock()
+	defer d.c.mtx.RUnlock()
+	return &distribution.QueryCommunityPoolResponse{Pool: d.c.state.communityPool}, nil
+}
+
+type slashingServer struct {
+	c *Chain
+}
+
+func (s slashingServer) Params(context.Context, *slashing.QueryParamsRequest) (*slashing.QueryParamsResponse, error) {
+	s.c.mtx.RLock()
+	defer s.c.mtx.RUnlock()
+	return &slashing.QueryParamsResponse{Params: s.c.state.slashingParams}, nil
+}
+
+func (s slashingServer) SigningInfo(_ context.Context, req *slashing.QuerySigningInfoRequest) (*slashing.QuerySigningInfoResponse, error) {
+	if _, err := consAddressFromBech32(req.ConsAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.c.mtx.RLock()
+	defer s.c.mtx.RUnlock()
+
+	info, ok := s.c.state.signingInfos[req.ConsAddress]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+	return &slashing.QuerySigningInfoResponse{ValSigningInfo: info}, nil
+}
+
+func (s slashingServer) SigningInfos(_ context.Context, req *slashing.QuerySigningInfosRequest) (*slashing.QuerySigningInfosResponse, error) {
+	s.c.mtx.RLock()
+	defer s.c.mtx.RUnlock()
+
+	addrs := make([]string, 0, len(s.c.state.signingInfos))
+	for addr := range s.c.state.signingInfos {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+
+	start, end, pageRes, err := paginateCoins(len(addrs), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]slashing.ValidatorSigningInfo, 0, end-start)
+	for _, addr := range addrs[start:end] {
+		infos = append(infos, s.c.state.signingInfos[addr])
+	}
+	return &slashing.QuerySigningInfosResponse{Info: infos, Pagination: pageRes}, nil
+}
+
+// paginateCoins returns the bounds of the page, the key of the next page is its big endian offset
+func paginateCoins(total int, req *query.PageRequest) (int, int, *query.PageResponse, error) {
+	if req == nil {
+		req = &query.PageRequest{}
+	}
+	if len(req.Key) > 0 && req.Offset > 0 {
+		return 0, 0, nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+
+	start := int(req.Offset)
+	if len(req.Key) > 0 {
+		if len(req.Key) != 8 {
+			return 0, 0, nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+		start = int(binary.BigEndian.Uint64(req.Key))
+	}
+	if start > total {
+		start = total
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	end := start + limit
+	if end > total {
+		end = total
+	}
+
+	res := &query.PageResponse{}
+	if end < total {
+		res.NextKey = make([]byte, 8)
+		binary.BigEndian.PutUint64(res.NextKey, uint64(end))
+	}
+	if req.CountTotal {
+		res.Total = uint64(total)
+	}
+	return start, end, res, nil
+}
+
+// makeEncodingConfig returns the encoding config of a client knowing the messages of all modules
+func makeEncodingConfig() sdk.EncodingConfig {
+	registry := cdctypes.NewInterfaceRegistry()
+	marshaler := codec.NewProtoCodec(registry)
+
+	registry.RegisterInterface("cosmos.v1beta1.Msg", (*sdk.Msg)(nil))
+	txtypes.RegisterInterfaces(registry)
+	cryptocodec.RegisterInterfaces(registry)
+	for _, registerInterfaces := range []func(cdctypes.InterfaceRegistry){
+		auth.RegisterInterfaces,
+		bank.RegisterInterfaces,
+		token.RegisterInterfaces,
+		transfer.RegisterInterfaces,
+		coinswap.RegisterInterfaces,
+		distribution.RegisterInterfaces,
+		gov.RegisterInterfaces,
+		htlc.RegisterInterfaces,
+		mint.RegisterInterfaces,
+		nft.RegisterInterfaces,
+		oracle.RegisterInterfaces,
+		params.RegisterInterfaces,
+		random.RegisterInterfaces,
+		record.RegisterInterfaces,
+		service.RegisterInterfaces,
+		slashing.RegisterInterfaces,
+		staking.RegisterInterfaces,
+		upgrade.RegisterInterfaces,
+	} {
+		registerInterfaces(registry)
+	}
+
+	return sdk.EncodingConfig{
+		InterfaceRegistry: registry,
+		Marshaler:         marshaler,
+		TxConfig:          txtypes.NewTxConfig(marshaler, txtypes.DefaultSignModes),
+		Amino:             makeAmino(),
+	}
+}
+
+func makeAmino() *codec.LegacyAmino {
+	amino := codec.NewLegacyAmino()
+	amino.RegisterInterface((*sdk.Msg)(nil), nil)
+	amino.RegisterInterface((*sdk.Tx)(nil), nil)
+	cryptocodec.RegisterCrypto(amino)
+	return amino
+}
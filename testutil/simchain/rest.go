@@ -0,0 +1,276 @@
+package simchain
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// restRoute is the GET endpoint of a grpc query method in the grpc-gateway
+type restRoute struct {
+	method   string
+	segments []string
+	request  reflect.Type
+	response reflect.Type
+}
+
+// RESTClientConfig returns the configuration of a client querying the modules through the REST gateway
+// of the chain, which only routes the queries of the modules served by the chain like the gateway of a node
+// without the other modules
+func (c *Chain) RESTClientConfig(options ...sdk.Option) (sdk.ClientConfig, error) {
+	c.mtx.Lock()
+	if c.gateway == nil {
+		routes, err := c.restRoutes()
+		if err != nil {
+			c.mtx.Unlock()
+			return sdk.ClientConfig{}, err
+		}
+		c.gateway = httptest.NewServer(c.restHandler(routes))
+	}
+	lcdAddr := c.gateway.URL
+	c.mtx.Unlock()
+
+	options = append([]sdk.Option{
+		sdk.TmClientOption(c),
+		sdk.TransportOption(sdk.REST),
+		sdk.LCDAddrOption(lcdAddr),
+	}, options...)
+	return sdk.NewClientConfig("simchain://"+c.chainID, bufnet, c.chainID, options...)
+}
+
+// restRoutes returns the routes of the google.api.http rules of the services served by the chain
+func (c *Chain) restRoutes() ([]restRoute, error) {
+	var routes []restRoute
+	for service, info := range c.restServices {
+		filename, ok := info.Metadata.(string)
+		if !ok {
+			return nil, fmt.Errorf("missing file of the service %s", service)
+		}
+
+		fd, err := fileDescriptor(filename)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, s := range fd.Service {
+			if fmt.Sprintf("%s.%s", fd.GetPackage(), s.GetName()) != service {
+				continue
+			}
+
+			for _, m := range s.Method {
+				if m.Options == nil {
+					continue
+				}
+				rule, ok := protov2.GetExtension(m.Options, annotations.E_Http).(*annotations.HttpRule)
+				if !ok || rule == nil || len(rule.GetGet()) == 0 {
+					continue
+				}
+
+				request := proto.MessageType(strings.TrimPrefix(m.GetInputType(), "."))
+				response := proto.MessageType(strings.TrimPrefix(m.GetOutputType(), "."))
+				if request == nil || response == nil {
+					return nil, fmt.Errorf("unknown messages of %s.%s", service, m.GetName())
+				}
+
+				routes = append(routes, restRoute{
+					method:   fmt.Sprintf("/%s/%s", service, m.GetName()),
+					segments: strings.Split(strings.Trim(rule.GetGet(), "/"), "/"),
+					request:  request.Elem(),
+					response: response.Elem(),
+				})
+			}
+		}
+	}
+	return routes, nil
+}
+
+// restHandler serves the routes through the grpc server, the unknown routes are answered
+// with the plain text 404 of the grpc-gateway
+func (c *Chain) restHandler(routes []restRoute) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		for _, route := range routes {
+			fields, ok := route.match(segments)
+			if !ok {
+				continue
+			}
+
+			for key, values := range r.URL.Query() {
+				fields[key] = values[0]
+			}
+			c.serveREST(w, r, route, fields)
+			return
+		}
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+	})
+}
+
+func (c *Chain) serveREST(w http.ResponseWriter, r *http.Request, route restRoute, fields map[string]string) {
+	req := reflect.New(route.request).Interface().(proto.Message)
+	if err := c.encodingConfig.Marshaler.UnmarshalJSON(nestFields(fields), req); err != nil {
+		writeRESTError(w, status.Error(codes.InvalidArgument, err.Error()))
+		return
+	}
+
+	ctx := context.Background()
+	if height := r.Header.Get(sdk.GRPCBlockHeightHeader); len(height) > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, sdk.GRPCBlockHeightHeader, height)
+	}
+
+	conn, err := c.GenConn()
+	if err != nil {
+		writeRESTError(w, status.Error(codes.Unavailable, err.Error()))
+		return
+	}
+	defer func() { _ = conn.Close() }()
+
+	res := reflect.New(route.response).Interface().(proto.Message)
+	if err := conn.Invoke(ctx, route.method, req, res); err != nil {
+		writeRESTError(w, err)
+		return
+	}
+
+	bz, err := c.encodingConfig.Marshaler.MarshalJSON(res)
+	if err != nil {
+		writeRESTError(w, status.Error(codes.Internal, err.Error()))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(bz)
+}
+
+// match returns the path parameters of the route if the path segments match its template
+func (route restRoute) match(segments []string) (map[string]string, bool) {
+	fields := make(map[string]string)
+	for i, segment := range route.segments {
+		if !strings.HasPrefix(segment, "{") {
+			if i >= len(segments) || segments[i] != segment {
+				return nil, false
+			}
+			continue
+		}
+
+		field := strings.SplitN(strings.Trim(segment, "{}"), "=", 2)
+		if len(field) == 2 && field[1] == "**" {
+			if i >= len(segments) {
+				return nil, false
+			}
+			fields[field[0]] = strings.Join(segments[i:], "/")
+			return fields, true
+		}
+		if i >= len(segments) || len(segments[i]) == 0 {
+			return nil, false
+		}
+		fields[field[0]] = segments[i]
+	}
+	return fields, len(segments) == len(route.segments)
+}
+
+// nestFields returns the proto-json of the fields, the nested fields are joined with dots
+func nestFields(fields map[string]string) []byte {
+	nested := make(map[string]interface{})
+	for key, value := range fields {
+		parent := nested
+		names := strings.Split(key, ".")
+		for _, name := range names[:len(names)-1] {
+			child, ok := parent[name].(map[string]interface{})
+			if !ok {
+				child = make(map[string]interface{})
+				parent[name] = child
+			}
+			parent = child
+		}
+
+		switch value {
+		case "true":
+			parent[names[len(names)-1]] = true
+		case "false":
+			parent[names[len(names)-1]] = false
+		default:
+			parent[names[len(names)-1]] = value
+		}
+	}
+
+	bz, _ := json.Marshal(nested)
+	return bz
+}
+
+// writeRESTError writes the grpc status of the error as the grpc-gateway does
+func writeRESTError(w http.ResponseWriter, err error) {
+	s := status.Convert(err)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus(s.Code()))
+	bz, _ := json.Marshal(map[string]interface{}{
+		"code":    s.Code(),
+		"message": s.Message(),
+		"details": []interface{}{},
+	})
+	_, _ = w.Write(bz)
+}
+
+// httpStatus maps the grpc code to the http status as the grpc-gateway does
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// fileDescriptor returns the descriptor of the proto file registered by the generated code
+func fileDescriptor(filename string) (*descriptorpb.FileDescriptorProto, error) {
+	gzipped := proto.FileDescriptor(filename)
+	if gzipped == nil {
+		return nil, fmt.Errorf("unknown proto file %s", filename)
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(gzipped))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = reader.Close() }()
+
+	bz, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	var fd descriptorpb.FileDescriptorProto
+	if err := protov2.Unmarshal(bz, &fd); err != nil {
+		return nil, err
+	}
+	return &fd, nil
+}
@@ -1,7 +1,7 @@
 package types
 
 import (
-	"google.golang.org/grpc"
+	gogogrpc "github.com/gogo/protobuf/grpc"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -12,6 +12,7 @@ type TxManager interface {
 	BuildAndSend(msg []Msg, baseTx BaseTx) (ResultTx, Error)
 	SendBatch(msgs Msgs, baseTx BaseTx) ([]ResultTx, Error)
 	BuildAndSendWithAccount(addr string, accountNumber, sequence uint64, msg []Msg, baseTx BaseTx) (ResultTx, Error)
+	BuildUnsignedTx(msgs []Msg, baseTx BaseTx) ([]byte, Error)
 }
 
 type Queries interface {
@@ -20,8 +21,14 @@ type Queries interface {
 	TmQuery
 }
 
+// QueryConn is the connection used by the generated module query clients
+type QueryConn interface {
+	gogogrpc.ClientConn
+	Close() error
+}
+
 type GRPCClient interface {
-	GenConn() (*grpc.ClientConn, error)
+	GenConn() (QueryConn, error)
 }
 
 type ParamQuery interface {
@@ -32,6 +39,7 @@ type StoreQuery interface {
 	QueryWithResponse(path string, data interface{}, result Response) error
 	Query(path string, data interface{}) ([]byte, error)
 	QueryStore(key HexBytes, storeName string, height int64, prove bool) (abci.ResponseQuery, error)
+	VerifiedQueryStore(key HexBytes, storeName string, height int64) (ProofResult, error)
 }
 
 type AccountQuery interface {
@@ -45,9 +53,19 @@ type TmQuery interface {
 	QueryBlock(height int64) (BlockDetail, error)
 }
 
+type LightClientQuery interface {
+	// QueryVerifiedHeader returns the header at the given height (0 for the latest)
+	// after verifying it with the light client
+	QueryVerifiedHeader(height int64) (*SignedHeader, error)
+}
+
 type TokenManager interface {
+	// QueryToken returns the token of the denom, the ibc vouchers ibc/{hash} have the symbol and
+	// the scale of the token of their base denom
 	QueryToken(denom string) (Token, error)
 	SaveTokens(tokens ...Token)
+	// QueryDenomTrace returns the trace of the ibc denom ibc/{hash} or of the hash
+	QueryDenomTrace(denom string) (DenomTrace, error)
 }
 
 type TokenConvert interface {
@@ -65,6 +83,7 @@ type BaseClient interface {
 	TokenManager
 	KeyManager
 	Queries
+	LightClientQuery
 	TokenConvert
 	TmClient
 	Logger
@@ -176,8 +176,7 @@ func (coins Coins) String() string {
 	return out[:len(out)-1]
 }
 
-// IsValid asserts the Coins are sorted, have positive amount,
-// and Denom does not contain upper case characters.
+// IsValid asserts the Coins are sorted, have positive amount and valid denoms.
 func (coins Coins) IsValid() bool {
 	switch len(coins) {
 	case 0:
@@ -195,7 +194,7 @@ func (coins Coins) IsValid() bool {
 
 		lowDenom := coins[0].Denom
 		for _, coin := range coins[1:] {
-			if strings.ToLower(coin.Denom) != coin.Denom {
+			if err := ValidateDenom(coin.Denom); err != nil {
 				return false
 			}
 			if coin.Denom <= lowDenom {
@@ -574,8 +573,9 @@ func (coins Coins) Sort() Coins {
 // Parsing
 
 var (
-	// Denominations can be 3 ~ 64 characters long.
-	reDnmString = `[a-z][a-z0-9:-]{2,15}`
+	// Denominations can be 3 ~ 128 characters long, like the liquidity tokens of coinswap `swap/<denom>`
+	// and the ibc vouchers `ibc/<HASH>` whose hash is in upper case.
+	reDnmString = `[a-zA-Z][a-zA-Z0-9/:-]{2,127}`
 	reAmt       = `[[:digit:]]+`
 	reDecAmt    = `[[:digit:]]*[.]*[[:digit:]]+`
 	reSpc       = `[[:space:]]*`
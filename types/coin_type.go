@@ -35,7 +35,8 @@ func (ct CoinType) ConvertToMainCoin(coin Coin) (DecCoin, error) {
 		return DecCoin{}, errors.New("coinType unit (%s) not defined" + coin.Denom)
 	}
 
-	if ct.isMainUnit(coin.Denom) {
+	// a denom shared by both units, e.g. an ibc voucher, is converted from the min unit
+	if ct.isMainUnit(coin.Denom) && !ct.isMinUnit(coin.Denom) {
 		return DecCoin{}, nil
 	}
 
@@ -54,7 +55,8 @@ func (ct CoinType) ConvertToMinCoin(coin DecCoin) (newCoin Coin, err error) {
 		return newCoin, errors.New("coinType unit (%s) not defined" + coin.Denom)
 	}
 
-	if ct.isMinUnit(coin.Denom) {
+	// a denom shared by both units, e.g. an ibc voucher, is converted from the main unit
+	if ct.isMinUnit(coin.Denom) && !ct.isMainUnit(coin.Denom) {
 		newCoin, _ := coin.TruncateDecimal()
 		return newCoin, nil
 	}
@@ -3,7 +3,12 @@ package types
 import (
 	"fmt"
 	"os"
+	"time"
 
+	tmmath "github.com/tendermint/tendermint/libs/math"
+	"github.com/tendermint/tendermint/light"
+
+	"github.com/irisnet/irishub-sdk-go/crypto/hd"
 	"github.com/irisnet/irishub-sdk-go/types/store"
 )
 
@@ -17,8 +22,20 @@ const (
 	defaultMode          = Sync
 	defaultPath          = "$HOME/irishub-sdk-go/leveldb"
 	defaultGasAdjustment = 1.0
+	defaultLightPath     = "$HOME/irishub-sdk-go/light"
+	defaultTransport     = GRPC
 )
 
+const (
+	// GRPC serves the module queries through the grpc server of the node
+	GRPC QueryTransport = "grpc"
+	// REST serves the module queries through the grpc-gateway endpoints of the node lcd
+	REST QueryTransport = "rest"
+)
+
+// QueryTransport defines the protocol used by the module queries
+type QueryTransport string
+
 type ClientConfig struct {
 	// irishub node rpc address
 	NodeURI string
@@ -26,6 +43,12 @@ type ClientConfig struct {
 	// irishub grpc address
 	GRPCAddr string
 
+	// irishub lcd(rest) address, only used by the rest transport
+	LCDAddr string
+
+	// transport of the module queries(grpc,rest)
+	Transport QueryTransport
+
 	// irishub chain-id
 	ChainID string
 
@@ -38,7 +61,10 @@ type ClientConfig struct {
 	// PrivKeyArmor DAO Implements
 	KeyDAO store.KeyDAO
 
-	// Private key generation algorithm(sm2,secp256k1)
+	// KeyManager signing the transactions instead of the keys of KeyDAO, e.g. a remote signer
+	KeyManager KeyManager
+
+	// Private key generation algorithm(sm2,secp256k1,ed25519)
 	Algo string
 
 	// Transaction broadcast Mode
@@ -58,6 +84,41 @@ type ClientConfig struct {
 
 	//whether to enable caching
 	Cached bool
+
+	//light client configuration, headers are not verified when nil
+	LightClient *LightClientConfig
+
+	//tendermint rpc client used instead of dialing NodeURI, e.g. an in-process chain
+	TmClient TmClient
+
+	//grpc client used instead of dialing GRPCAddr or LCDAddr, e.g. an in-process chain
+	GRPCClient GRPCClient
+}
+
+// LightClientConfig configures the embedded tendermint light client
+type LightClientConfig struct {
+	// height and hash of a header trusted through a social consensus
+	TrustedHeight int64
+	TrustedHash   HexBytes
+
+	// period during which the trusted validator set can be used to verify new headers,
+	// should be significantly less than the unbonding period
+	TrustingPeriod time.Duration
+
+	// verify every header in ascending order instead of skipping (bisection)
+	Sequential bool
+
+	// fraction of the trusted validator set which must sign a new header when skipping,
+	// defaults to 1/3
+	TrustLevel tmmath.Fraction
+
+	// rpc addresses of the nodes cross-checking the headers of the primary node. When empty the
+	// primary node witnesses itself: the headers are still verified against the trusted validator
+	// set, but a fork served by the primary node is not detected
+	Witnesses []string
+
+	// directory of the leveldb storing the trusted headers
+	DBDir string
 }
 
 func NewClientConfig(uri, grpcAddr, chainID string, options ...Option) (ClientConfig, error) {
@@ -99,8 +160,11 @@ func (cfg *ClientConfig) checkAndSetDefault() error {
 		return err
 	}
 
-	if err := KeyDAOOption(cfg.KeyDAO)(cfg); err != nil {
-		return err
+	// the keys of KeyDAO are not used by a custom KeyManager
+	if cfg.KeyManager == nil {
+		if err := KeyDAOOption(cfg.KeyDAO)(cfg); err != nil {
+			return err
+		}
 	}
 
 	if err := ModeOption(cfg.Mode)(cfg); err != nil {
@@ -119,6 +183,14 @@ func (cfg *ClientConfig) checkAndSetDefault() error {
 		return err
 	}
 
+	if err := TransportOption(cfg.Transport)(cfg); err != nil {
+		return err
+	}
+
+	if cfg.Transport == REST && len(cfg.LCDAddr) == 0 {
+		return fmt.Errorf("lcdAddr is required by the rest transport")
+	}
+
 	return GasAdjustmentOption(cfg.GasAdjustment)(cfg)
 }
 
@@ -150,6 +222,13 @@ func KeyDAOOption(dao store.KeyDAO) Option {
 	}
 }
 
+func KeyManagerOption(km KeyManager) Option {
+	return func(cfg *ClientConfig) error {
+		cfg.KeyManager = km
+		return nil
+	}
+}
+
 func GasOption(gas uint64) Option {
 	return func(cfg *ClientConfig) error {
 		if gas <= 0 {
@@ -165,6 +244,9 @@ func AlgoOption(algo string) Option {
 		if algo == "" {
 			algo = defaultAlgo
 		}
+		if _, err := hd.NewSigningAlgoFromString(algo); err != nil {
+			return err
+		}
 		cfg.Algo = algo
 		return nil
 	}
@@ -220,6 +302,67 @@ func GasAdjustmentOption(gasAdjustment float64) Option {
 	}
 }
 
+func LightClientOption(lightCfg LightClientConfig) Option {
+	return func(cfg *ClientConfig) error {
+		if lightCfg.TrustingPeriod <= 0 {
+			return fmt.Errorf("trusting period must be positive")
+		}
+
+		if lightCfg.TrustedHeight <= 0 {
+			return fmt.Errorf("trusted height must be positive")
+		}
+
+		if lightCfg.TrustLevel.Denominator == 0 {
+			lightCfg.TrustLevel = light.DefaultTrustLevel
+		}
+
+		if err := light.ValidateTrustLevel(lightCfg.TrustLevel); err != nil {
+			return err
+		}
+
+		if len(lightCfg.DBDir) == 0 {
+			lightCfg.DBDir = os.ExpandEnv(defaultLightPath)
+		}
+		cfg.LightClient = &lightCfg
+		return nil
+	}
+}
+
+func TransportOption(transport QueryTransport) Option {
+	return func(cfg *ClientConfig) error {
+		switch transport {
+		case "":
+			transport = defaultTransport
+		case GRPC, REST:
+		default:
+			return fmt.Errorf("transport %s is not supported", transport)
+		}
+		cfg.Transport = transport
+		return nil
+	}
+}
+
+func LCDAddrOption(lcdAddr string) Option {
+	return func(cfg *ClientConfig) error {
+		cfg.LCDAddr = lcdAddr
+		return nil
+	}
+}
+
+func TmClientOption(client TmClient) Option {
+	return func(cfg *ClientConfig) error {
+		cfg.TmClient = client
+		return nil
+	}
+}
+
+func GRPCClientOption(client GRPCClient) Option {
+	return func(cfg *ClientConfig) error {
+		cfg.GRPCClient = client
+		return nil
+	}
+}
+
 func CachedOption(enabled bool) Option {
 	return func(cfg *ClientConfig) error {
 		cfg.Cached = enabled
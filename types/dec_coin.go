@@ -497,8 +497,7 @@ func (coins DecCoins) IsZero() bool {
 	return true
 }
 
-// IsValid asserts the DecCoins are sorted, have positive amount, and Denom
-// does not contain upper case characters.
+// IsValid asserts the DecCoins are sorted, have positive amount and valid denoms.
 func (coins DecCoins) IsValid() bool {
 	switch len(coins) {
 	case 0:
@@ -518,7 +517,7 @@ func (coins DecCoins) IsValid() bool {
 
 		lowDenom := coins[0].Denom
 		for _, coin := range coins[1:] {
-			if strings.ToLower(coin.Denom) != coin.Denom {
+			if err := ValidateDenom(coin.Denom); err != nil {
 				return false
 			}
 			if coin.Denom <= lowDenom {
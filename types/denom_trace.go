@@ -0,0 +1,60 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// IBCDenomPrefix is the prefix of the denoms of the tokens received over ibc
+const IBCDenomPrefix = "ibc/"
+
+// DenomTrace is the origin of an ibc voucher: the ports and channels the token was transferred
+// through, and its denom on the source chain
+type DenomTrace struct {
+	// Path is the chain of port/channel identifiers, e.g. transfer/channel-0
+	Path      string `json:"path"`
+	BaseDenom string `json:"base_denom"`
+}
+
+// FullPath returns the denom of the voucher before hashing, e.g. transfer/channel-0/uatom
+func (dt DenomTrace) FullPath() string {
+	if len(dt.Path) == 0 {
+		return dt.BaseDenom
+	}
+	return dt.Path + "/" + dt.BaseDenom
+}
+
+// Hash returns the sha256 of the full path
+func (dt DenomTrace) Hash() HexBytes {
+	hash := sha256.Sum256([]byte(dt.FullPath()))
+	return hash[:]
+}
+
+// IBCDenom returns the denom of the voucher on this chain, ibc/{hash}, or the base
+// denom if the token is native
+func (dt DenomTrace) IBCDenom() string {
+	if len(dt.Path) == 0 {
+		return dt.BaseDenom
+	}
+	return IBCDenomPrefix + dt.Hash().String()
+}
+
+// IsIBCDenom returns true if the denom is an ibc voucher
+func IsIBCDenom(denom string) bool {
+	return strings.HasPrefix(strings.ToLower(denom), IBCDenomPrefix)
+}
+
+// ParseIBCDenomHash returns the hash of the ibc denom ibc/{hash}, the hash can also be given alone
+func ParseIBCDenomHash(denom string) (HexBytes, error) {
+	if IsIBCDenom(denom) {
+		denom = denom[len(IBCDenomPrefix):]
+	}
+
+	hash, err := hex.DecodeString(denom)
+	if err != nil || len(hash) != sha256.Size {
+		return nil, fmt.Errorf("invalid ibc denom hash: %s", denom)
+	}
+	return HexBytes(hash), nil
+}
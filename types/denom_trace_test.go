@@ -0,0 +1,39 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDenomTrace(t *testing.T) {
+	trace := DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}
+	require.Equal(t, "transfer/channel-0/uatom", trace.FullPath())
+	require.Equal(t, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", trace.IBCDenom())
+	require.True(t, IsIBCDenom(trace.IBCDenom()))
+
+	native := DenomTrace{BaseDenom: "uiris"}
+	require.Equal(t, "uiris", native.IBCDenom())
+	require.False(t, IsIBCDenom(native.IBCDenom()))
+
+	hash, err := ParseIBCDenomHash(trace.IBCDenom())
+	require.NoError(t, err)
+	require.Equal(t, trace.Hash(), hash)
+
+	_, err = ParseIBCDenomHash("ibc/27394FB0")
+	require.Error(t, err)
+	_, err = ParseIBCDenomHash("uiris")
+	require.Error(t, err)
+}
+
+func TestIBCDenomCoins(t *testing.T) {
+	denom := DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}.IBCDenom()
+
+	coin, err := ParseCoin("100" + denom)
+	require.NoError(t, err)
+	require.Equal(t, denom, coin.Denom)
+
+	coins := NewCoins(NewInt64Coin("uiris", 1), coin)
+	require.True(t, coins.IsValid())
+	require.True(t, NewDecCoinsFromCoins(coins...).IsValid())
+}
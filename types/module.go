@@ -4,6 +4,8 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	cdctypes "github.com/irisnet/irishub-sdk-go/codec/types"
+	"github.com/irisnet/irishub-sdk-go/crypto/hd"
+	"github.com/irisnet/irishub-sdk-go/types/store"
 )
 
 //The purpose of this interface is to convert the irishub system type to the user receiving type
@@ -24,10 +26,71 @@ type Module interface {
 
 type KeyManager interface {
 	Sign(name, password string, data []byte) ([]byte, crypto.PubKey, error)
-	Insert(name, password string) (string, string, error)
-	Recover(name, password, mnemonic string) (string, error)
+	Insert(name, password string, options ...KeyOption) (string, string, error)
+	Recover(name, password, mnemonic string, options ...KeyOption) (string, error)
 	Import(name, password string, privKeyArmor string) (address string, err error)
+	ImportPubKey(name, password string, pubKey crypto.PubKey) (address string, err error)
+	ImportMultisig(name, password string, threshold int, pubKeys []crypto.PubKey) (address string, err error)
 	Export(name, password string) (privKeyArmor string, err error)
 	Delete(name, password string) error
+	ChangePassword(name, oldPassword, newPassword string) error
 	Find(name, password string) (crypto.PubKey, AccAddress, error)
+	Info(name string) (store.KeyInfo, error)
+	List() ([]store.KeyInfo, error)
+}
+
+// KeyOptions are the parameters of a key created or recovered by the KeyManager
+type KeyOptions struct {
+	// Algo is the signing algorithm of the key, the algorithm of the client is used if empty
+	Algo string
+	// HDPath is the BIP44 path of the key derived from the mnemonic, 44'/118'/0'/0/0 by default
+	HDPath hd.BIP44Params
+	// BIP39Passphrase is the passphrase(the 25th word) of the mnemonic
+	BIP39Passphrase string
+}
+
+// KeyOption customizes a key created or recovered by the KeyManager
+type KeyOption func(opts *KeyOptions)
+
+// DefaultKeyOptions returns the options of the keys of a client using the signing algorithm algo
+func DefaultKeyOptions(algo string) KeyOptions {
+	return KeyOptions{
+		Algo:   algo,
+		HDPath: *hd.NewFundraiserParams(0, hd.CoinType, 0),
+	}
+}
+
+// KeyAlgoOption selects the signing algorithm of a key(sm2,secp256k1,ed25519)
+func KeyAlgoOption(algo string) KeyOption {
+	return func(opts *KeyOptions) {
+		opts.Algo = algo
+	}
+}
+
+// KeyHDPathOption derives the key at the BIP44 path
+func KeyHDPathOption(path hd.BIP44Params) KeyOption {
+	return func(opts *KeyOptions) {
+		opts.HDPath = path
+	}
+}
+
+// KeyAccountOption derives the key of the BIP44 account
+func KeyAccountOption(account uint32) KeyOption {
+	return func(opts *KeyOptions) {
+		opts.HDPath.Account = account
+	}
+}
+
+// KeyIndexOption derives the key at the BIP44 address index
+func KeyIndexOption(index uint32) KeyOption {
+	return func(opts *KeyOptions) {
+		opts.HDPath.AddressIndex = index
+	}
+}
+
+// KeyPassphraseOption derives the key with the BIP39 passphrase of the mnemonic
+func KeyPassphraseOption(passphrase string) KeyOption {
+	return func(opts *KeyOptions) {
+		opts.BIP39Passphrase = passphrase
+	}
 }
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// GRPCBlockHeightHeader is the grpc metadata selecting the height of the state of a query
+const GRPCBlockHeightHeader = "x-cosmos-block-height"
+
+// ContextWithHeight returns the context of the queries of the state at the height, the latest
+// state is queried if the height is 0
+func ContextWithHeight(ctx context.Context, height int64) context.Context {
+	if height <= 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+}
+
+// ParamsModule is implemented by the modules whose params are queried by the ParamQuery
+type ParamsModule interface {
+	Module
+	// QueryParamsResponse returns the grpc response of the params of the module, the height of
+	// the query is set by ContextWithHeight. The grpc error is not wrapped so that its status is kept.
+	QueryParamsResponse(ctx context.Context) (Response, error)
+}
+
+// ChainParams is the snapshot of the params of the modules at a height
+type ChainParams struct {
+	Height int64 `json:"height"`
+	// Params are the converted params of the modules keyed by the module name
+	Params map[string]interface{} `json:"params"`
+}
+
+// ParamChange is a param whose value differs between two snapshots
+type ParamChange struct {
+	Module string `json:"module"`
+	// Key is the json path of the param in the params of the module, e.g. deposit_params.min_deposit
+	Key string `json:"key"`
+	// Old and New are the json encoded values, empty if the param is missing in the snapshot
+	Old string `json:"old"`
+	New string `json:"new"`
+}
+
+// DiffChainParams returns the params changed from the snapshot from to the snapshot to,
+// sorted by the module and the key
+func DiffChainParams(from, to ChainParams) ([]ParamChange, error) {
+	modules := make(map[string]bool)
+	for module := range from.Params {
+		modules[module] = true
+	}
+	for module := range to.Params {
+		modules[module] = true
+	}
+
+	var changes []ParamChange
+	for module := range modules {
+		oldParams, err := flattenParams(from.Params[module])
+		if err != nil {
+			return nil, err
+		}
+		newParams, err := flattenParams(to.Params[module])
+		if err != nil {
+			return nil, err
+		}
+
+		for key, value := range oldParams {
+			if newParams[key] != value {
+				changes = append(changes, ParamChange{Module: module, Key: key, Old: value, New: newParams[key]})
+			}
+		}
+		for key, value := range newParams {
+			if _, ok := oldParams[key]; !ok {
+				changes = append(changes, ParamChange{Module: module, Key: key, New: value})
+			}
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Module != changes[j].Module {
+			return changes[i].Module < changes[j].Module
+		}
+		return changes[i].Key < changes[j].Key
+	})
+	return changes, nil
+}
+
+// flattenParams returns the json encoded values of the params keyed by their json path,
+// the arrays are compared as a whole
+func flattenParams(params interface{}) (map[string]string, error) {
+	values := make(map[string]string)
+	if params == nil {
+		return values, nil
+	}
+
+	bz, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields interface{}
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+	return values, flattenValue("", fields, values)
+}
+
+func flattenValue(prefix string, value interface{}, values map[string]string) error {
+	if fields, ok := value.(map[string]interface{}); ok && (len(fields) > 0 || len(prefix) == 0) {
+		for key, field := range fields {
+			if len(prefix) > 0 {
+				key = prefix + "." + key
+			}
+			if err := flattenValue(key, field, values); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	values[prefix] = string(bz)
+	return nil
+}
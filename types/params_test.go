@@ -0,0 +1,64 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+type depositParams struct {
+	MinDeposit Coins `json:"min_deposit"`
+	Period     int64 `json:"period"`
+}
+
+type govParams struct {
+	Quorum  Dec           `json:"quorum"`
+	Deposit depositParams `json:"deposit_params"`
+}
+
+func TestDiffChainParams(t *testing.T) {
+	from := ChainParams{
+		Height: 10,
+		Params: map[string]interface{}{
+			"gov": govParams{
+				Quorum:  NewDecWithPrec(334, 3),
+				Deposit: depositParams{MinDeposit: NewCoins(NewInt64Coin("uiris", 10)), Period: 100},
+			},
+			"bank": map[string]bool{"default_send_enabled": true},
+		},
+	}
+	to := ChainParams{
+		Height: 20,
+		Params: map[string]interface{}{
+			"gov": govParams{
+				Quorum:  NewDecWithPrec(4, 1),
+				Deposit: depositParams{MinDeposit: NewCoins(NewInt64Coin("uiris", 20)), Period: 100},
+			},
+			"bank":  map[string]bool{"default_send_enabled": true},
+			"token": map[string]string{"token_tax_rate": "0.4"},
+		},
+	}
+
+	changes, err := DiffChainParams(from, to)
+	require.NoError(t, err)
+	require.Equal(t, []ParamChange{
+		{Module: "gov", Key: "deposit_params.min_deposit", Old: `[{"amount":"10","denom":"uiris"}]`, New: `[{"amount":"20","denom":"uiris"}]`},
+		{Module: "gov", Key: "quorum", Old: `"0.334000000000000000"`, New: `"0.400000000000000000"`},
+		{Module: "token", Key: "token_tax_rate", New: `"0.4"`},
+	}, changes)
+
+	changes, err = DiffChainParams(to, to)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+}
+
+func TestContextWithHeight(t *testing.T) {
+	_, ok := metadata.FromOutgoingContext(ContextWithHeight(context.Background(), 0))
+	require.False(t, ok)
+
+	md, ok := metadata.FromOutgoingContext(ContextWithHeight(context.Background(), 15))
+	require.True(t, ok)
+	require.Equal(t, []string{"15"}, md.Get(GRPCBlockHeightHeader))
+}
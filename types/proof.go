@@ -1,6 +1,22 @@
 package types
 
-import "github.com/tendermint/tendermint/proto/tendermint/crypto"
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+
+	ics23 "github.com/irisnet/irishub-sdk-go/third_party/github.com/confio/ics23/go"
+)
+
+const (
+	// ProofOpIAVLCommitment is the proof type of a key inside a module store
+	ProofOpIAVLCommitment = "ics23:iavl"
+	// ProofOpSimpleMerkleCommitment is the proof type of a module store inside the multistore
+	ProofOpSimpleMerkleCommitment = "ics23:simple"
+)
+
+var proofRuntime = newProofRuntime()
 
 type ProofValue struct {
 	Proof []byte   `json:"proof"`
@@ -11,3 +27,115 @@ type ProofValue struct {
 type MerkleProof struct {
 	Proof *crypto.ProofOps `json:"proof"`
 }
+
+// ProofResult is the result of a store query whose proof has been verified against a trusted app hash
+type ProofResult struct {
+	StoreName string   `json:"store_name"`
+	Key       HexBytes `json:"key"`
+	Value     HexBytes `json:"value"`
+	// Exists reports whether the proof is a membership (true) or a non-membership (false) proof
+	Exists bool `json:"exists"`
+	// Height is the height of the queried state
+	Height int64 `json:"height"`
+	// AppHash is the app hash of the header at Height+1 the proof was verified against
+	AppHash HexBytes `json:"app_hash"`
+}
+
+// VerifyProof verifies that `value` is stored under `key` in the module store `storeName`
+// of the multistore whose root is `appHash`. An empty value proves the absence of the key.
+func VerifyProof(proofOps *crypto.ProofOps, appHash []byte, storeName string, key, value []byte) error {
+	if proofOps == nil || len(proofOps.Ops) == 0 {
+		return fmt.Errorf("proof is empty")
+	}
+	if len(appHash) == 0 {
+		return fmt.Errorf("app hash is empty")
+	}
+
+	keyPath := new(merkle.KeyPath).
+		AppendKey([]byte(storeName), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingURL).
+		String()
+
+	if len(value) == 0 {
+		return proofRuntime.VerifyAbsence(proofOps, appHash, keyPath)
+	}
+	return proofRuntime.VerifyValue(proofOps, appHash, keyPath, value)
+}
+
+func newProofRuntime() *merkle.ProofRuntime {
+	prt := merkle.NewProofRuntime()
+	prt.RegisterOpDecoder(ProofOpIAVLCommitment, commitmentOpDecoder)
+	prt.RegisterOpDecoder(ProofOpSimpleMerkleCommitment, commitmentOpDecoder)
+	return prt
+}
+
+// commitmentOp implements merkle.ProofOperator by wrapping an ics23 CommitmentProof
+type commitmentOp struct {
+	Type  string
+	Spec  *ics23.ProofSpec
+	Key   []byte
+	Proof *ics23.CommitmentProof
+}
+
+func commitmentOpDecoder(pop crypto.ProofOp) (merkle.ProofOperator, error) {
+	var spec *ics23.ProofSpec
+	switch pop.Type {
+	case ProofOpIAVLCommitment:
+		spec = ics23.IavlSpec
+	case ProofOpSimpleMerkleCommitment:
+		spec = ics23.TendermintSpec
+	default:
+		return nil, fmt.Errorf("unexpected proof op type %s", pop.Type)
+	}
+
+	proof := &ics23.CommitmentProof{}
+	if err := proof.Unmarshal(pop.Data); err != nil {
+		return nil, err
+	}
+
+	return commitmentOp{
+		Type:  pop.Type,
+		Spec:  spec,
+		Key:   pop.Key,
+		Proof: proof,
+	}, nil
+}
+
+func (op commitmentOp) GetKey() []byte {
+	return op.Key
+}
+
+// Run proves the absence of the key when args is empty, otherwise the existence of
+// the key with value args[0], and returns the calculated root
+func (op commitmentOp) Run(args [][]byte) ([][]byte, error) {
+	root, err := op.Proof.Calculate()
+	if err != nil {
+		return nil, fmt.Errorf("could not calculate root for proof: %s", err.Error())
+	}
+
+	switch len(args) {
+	case 0:
+		if !ics23.VerifyNonMembership(op.Spec, root, op.Proof, op.Key) {
+			return nil, fmt.Errorf("proof did not verify absence of key: %s", string(op.Key))
+		}
+	case 1:
+		if !ics23.VerifyMembership(op.Spec, root, op.Proof, op.Key, args[0]) {
+			return nil, fmt.Errorf("proof did not verify existence of key %s with given value %X", op.Key, args[0])
+		}
+	default:
+		return nil, fmt.Errorf("args must be length 0 or 1, got: %d", len(args))
+	}
+	return [][]byte{root}, nil
+}
+
+func (op commitmentOp) ProofOp() crypto.ProofOp {
+	bz, err := op.Proof.Marshal()
+	if err != nil {
+		panic(err.Error())
+	}
+	return crypto.ProofOp{
+		Type: op.Type,
+		Key:  op.Key,
+		Data: bz,
+	}
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+
+	ics23 "github.com/irisnet/irishub-sdk-go/third_party/github.com/confio/ics23/go"
+)
+
+func TestVerifyProof(t *testing.T) {
+	storeName, key, value := "record", []byte("key"), []byte("value")
+
+	// a module store with a single sibling
+	storeProof := &ics23.ExistenceProof{
+		Key:   key,
+		Value: value,
+		Leaf:  ics23.IavlSpec.LeafSpec,
+		Path: []*ics23.InnerOp{{
+			Hash:   ics23.HashOp_SHA256,
+			Prefix: []byte{2, 4, 2, 32},
+			Suffix: append([]byte{32}, bytes.Repeat([]byte{1}, 32)...),
+		}},
+	}
+	storeRoot, err := storeProof.Calculate()
+	require.NoError(t, err)
+
+	// a multistore with a single sibling
+	multiStoreProof := &ics23.ExistenceProof{
+		Key:   []byte(storeName),
+		Value: storeRoot,
+		Leaf:  ics23.TendermintSpec.LeafSpec,
+		Path: []*ics23.InnerOp{{
+			Hash:   ics23.HashOp_SHA256,
+			Prefix: []byte{1},
+			Suffix: bytes.Repeat([]byte{2}, 32),
+		}},
+	}
+	appHash, err := multiStoreProof.Calculate()
+	require.NoError(t, err)
+
+	proofOps := &crypto.ProofOps{Ops: []crypto.ProofOp{
+		commitmentOp{Type: ProofOpIAVLCommitment, Key: key, Proof: existProof(storeProof)}.ProofOp(),
+		commitmentOp{Type: ProofOpSimpleMerkleCommitment, Key: []byte(storeName), Proof: existProof(multiStoreProof)}.ProofOp(),
+	}}
+
+	require.NoError(t, VerifyProof(proofOps, appHash, storeName, key, value))
+	require.Error(t, VerifyProof(proofOps, appHash, storeName, key, []byte("fake")))
+	require.Error(t, VerifyProof(proofOps, appHash, "bank", key, value))
+	require.Error(t, VerifyProof(proofOps, []byte("fake"), storeName, key, value))
+	require.Error(t, VerifyProof(proofOps, appHash, storeName, key, nil))
+	require.Error(t, VerifyProof(nil, appHash, storeName, key, value))
+}
+
+func existProof(proof *ics23.ExistenceProof) *ics23.CommitmentProof {
+	return &ics23.CommitmentProof{
+		Proof: &ics23.CommitmentProof_Exist{Exist: proof},
+	}
+}
@@ -0,0 +1,163 @@
+package store
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	backupFormat  = "irishub-sdk-go/keys-backup"
+	backupVersion = 1
+)
+
+// PasswordFunc returns the password of the key
+type PasswordFunc func(name string) string
+
+// archive is the versioned backup of a KeyDAO, the keys are encrypted by the password of the archive
+type archive struct {
+	Format    string    `json:"format"`
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	Keys      int       `json:"keys"`
+	// Checksum is the hex encoded sha256 of the decrypted keys
+	Checksum string `json:"checksum"`
+	Data     string `json:"data"`
+}
+
+// archivedKey is a key of the archive, the private key is not a valid utf-8 string,
+// so it is kept as bytes instead of the PrivKeyArmor of the information
+type archivedKey struct {
+	Info    KeyInfo `json:"info"`
+	PrivKey []byte  `json:"priv_key,omitempty"`
+}
+
+// Backup snapshots all keys of the KeyDAO into an archive encrypted by the password with Scrypt,
+// the keys are decrypted by the passwords returned by passwords, which are not archived.
+func Backup(dao KeyDAO, password string, passwords PasswordFunc) ([]byte, error) {
+	if len(password) == 0 {
+		return nil, fmt.Errorf("no password")
+	}
+
+	infos, err := dao.List()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]archivedKey, 0, len(infos))
+	for _, metadata := range infos {
+		// the public keys are encrypted as well by some backends, e.g. the FileDAO
+		var keyPassword string
+		if passwords != nil {
+			keyPassword = passwords(metadata.Name)
+		}
+
+		info, err := dao.Read(metadata.Name, keyPassword)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %s", metadata.Name, err.Error())
+		}
+		if info.Type == TypeLocal {
+			if err := checkPrivKey(info); err != nil {
+				return nil, fmt.Errorf("invalid password of %s", metadata.Name)
+			}
+		}
+		info.Name = metadata.Name
+		privKey := []byte(info.PrivKeyArmor)
+		info.PrivKeyArmor = ""
+		keys = append(keys, archivedKey{Info: info, PrivKey: privKey})
+	}
+
+	bz, err := json.Marshal(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := Scrypt{}.Encrypt(string(bz), password)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum := sha256.Sum256(bz)
+	return json.MarshalIndent(archive{
+		Format:    backupFormat,
+		Version:   backupVersion,
+		CreatedAt: time.Now().UTC(),
+		Keys:      len(keys),
+		Checksum:  hex.EncodeToString(checksum[:]),
+		Data:      data,
+	}, "", "  ")
+}
+
+// Restore writes the keys of the archive into the KeyDAO, which can be another backend than the one
+// backed up. The keys are encrypted by the passwords returned by newPasswords, the password of the
+// archive is used if newPasswords is nil or returns an empty password.
+// Nothing is written if a key of the archive exists in the KeyDAO, and the keys already written
+// are deleted if one of them fails to be written.
+func Restore(dao KeyDAO, bz []byte, password string, newPasswords PasswordFunc) ([]string, error) {
+	var a archive
+	if err := json.Unmarshal(bz, &a); err != nil {
+		return nil, err
+	}
+	if a.Format != backupFormat {
+		return nil, fmt.Errorf("invalid backup format: %s", a.Format)
+	}
+	if a.Version != backupVersion {
+		return nil, fmt.Errorf("unsupported backup version: %d", a.Version)
+	}
+
+	data, err := Scrypt{}.Decrypt(a.Data, password)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum := sha256.Sum256([]byte(data))
+	if hex.EncodeToString(checksum[:]) != a.Checksum {
+		return nil, fmt.Errorf("backup checksum mismatch")
+	}
+
+	var keys []archivedKey
+	if err := json.Unmarshal([]byte(data), &keys); err != nil {
+		return nil, err
+	}
+	if len(keys) != a.Keys {
+		return nil, fmt.Errorf("backup has %d keys, expected %d", len(keys), a.Keys)
+	}
+
+	seen := make(map[string]bool, len(keys))
+	for i := range keys {
+		key := &keys[i]
+		key.Info.PrivKeyArmor = string(key.PrivKey)
+		if dao.Has(key.Info.Name) || seen[key.Info.Name] {
+			return nil, fmt.Errorf("name %s has exist", key.Info.Name)
+		}
+		seen[key.Info.Name] = true
+		if key.Info.Type == TypeLocal {
+			if err := checkPrivKey(key.Info); err != nil {
+				return nil, fmt.Errorf("invalid key %s: %s", key.Info.Name, err.Error())
+			}
+		}
+	}
+
+	names := make([]string, 0, len(keys))
+	keyPasswords := make([]string, 0, len(keys))
+	for _, key := range keys {
+		keyPassword := password
+		if newPasswords != nil {
+			if newPassword := newPasswords(key.Info.Name); len(newPassword) > 0 {
+				keyPassword = newPassword
+			}
+		}
+
+		if err := dao.Write(key.Info.Name, keyPassword, key.Info); err != nil {
+			for i, name := range names {
+				_ = dao.Delete(name, keyPasswords[i])
+			}
+			return nil, fmt.Errorf("failed to restore %s: %s", key.Info.Name, err.Error())
+		}
+		names = append(names, key.Info.Name)
+		keyPasswords = append(keyPasswords, keyPassword)
+	}
+	return names, nil
+}
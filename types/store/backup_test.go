@@ -0,0 +1,155 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cryptoamino "github.com/irisnet/irishub-sdk-go/crypto/codec"
+	"github.com/irisnet/irishub-sdk-go/crypto/keys/secp256k1"
+	"github.com/irisnet/irishub-sdk-go/crypto/keys/sm2"
+)
+
+func TestBackup(t *testing.T) {
+	levelDB, err := NewLevelDB(t.TempDir(), nil)
+	require.NoError(t, err)
+
+	passwords := map[string]string{"alice": "12345678", "bob": "87654321", "watch": "watchpwd"}
+	alice, bob := secp256k1.GenPrivKey(), sm2.GenPrivKey()
+	infos := []KeyInfo{{
+		Name:         "alice",
+		PubKey:       cryptoamino.MarshalPubkey(alice.PubKey()),
+		PrivKeyArmor: string(cryptoamino.MarshalPrivKey(alice)),
+		Algo:         "secp256k1",
+		HDPath:       "44'/118'/0'/0/0",
+	}, {
+		Name:         "bob",
+		PubKey:       cryptoamino.MarshalPubkey(bob.PubKey()),
+		PrivKeyArmor: string(cryptoamino.MarshalPrivKey(bob)),
+		Algo:         "sm2",
+	}, {
+		Name:   "watch",
+		PubKey: cryptoamino.MarshalPubkey(secp256k1.GenPrivKey().PubKey()),
+		Algo:   "secp256k1",
+		Type:   TypeOffline,
+	}}
+	for _, info := range infos {
+		require.NoError(t, levelDB.Write(info.Name, passwords[info.Name], info))
+	}
+
+	_, err = Backup(levelDB, "backup", func(name string) string { return "wrong" })
+	require.Error(t, err)
+
+	bz, err := Backup(levelDB, "backup", func(name string) string { return passwords[name] })
+	require.NoError(t, err)
+
+	// restore into the file backend with a new password of alice
+	fileDAO, err := NewFileDAO(t.TempDir())
+	require.NoError(t, err)
+
+	_, err = Restore(fileDAO, bz, "wrong", nil)
+	require.Error(t, err)
+
+	names, err := Restore(fileDAO, bz, "backup", func(name string) string {
+		if name == "alice" {
+			return "abcdefgh"
+		}
+		return ""
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice", "bob", "watch"}, names)
+
+	for _, info := range infos {
+		// the keys without a new password are encrypted by the password of the archive
+		password := "backup"
+		if info.Name == "alice" {
+			password = "abcdefgh"
+		}
+		read, err := fileDAO.Read(info.Name, password)
+		require.NoError(t, err)
+		require.Equal(t, info.PrivKeyArmor, read.PrivKeyArmor)
+		require.Equal(t, info.PubKey, read.PubKey)
+		require.Equal(t, info.HDPath, read.HDPath)
+		require.Equal(t, info.Type, read.Type)
+	}
+	_, err = fileDAO.Read("alice", passwords["alice"])
+	require.Error(t, err)
+	_, err = fileDAO.Read("bob", passwords["bob"])
+	require.Error(t, err)
+
+	// the passwords of the keys are not archived
+	var a archive
+	require.NoError(t, json.Unmarshal(bz, &a))
+	data, err := Scrypt{}.Decrypt(a.Data, "backup")
+	require.NoError(t, err)
+	for _, password := range passwords {
+		require.NotContains(t, data, password)
+	}
+
+	// the existing keys are not overwritten
+	_, err = Restore(fileDAO, bz, "backup", nil)
+	require.Error(t, err)
+
+	// the archive is verified
+	a.Checksum = "00"
+	tampered, err := json.Marshal(a)
+	require.NoError(t, err)
+	_, err = Restore(NewMemory(nil), tampered, "backup", nil)
+	require.Error(t, err)
+
+	a.Version = 2
+	tampered, err = json.Marshal(a)
+	require.NoError(t, err)
+	_, err = Restore(NewMemory(nil), tampered, "backup", nil)
+	require.Error(t, err)
+}
+
+// failingDAO fails to write the key named fail
+type failingDAO struct {
+	KeyDAO
+	fail string
+}
+
+func (dao failingDAO) Write(name, password string, info KeyInfo) error {
+	if name == dao.fail {
+		return fmt.Errorf("write %s failed", name)
+	}
+	return dao.KeyDAO.Write(name, password, info)
+}
+
+func TestRestoreRollback(t *testing.T) {
+	src := NewMemory(nil)
+	for _, name := range []string{"alice", "bob", "carol"} {
+		privKey := secp256k1.GenPrivKey()
+		require.NoError(t, src.Write(name, "12345678", KeyInfo{
+			Name:         name,
+			PubKey:       cryptoamino.MarshalPubkey(privKey.PubKey()),
+			PrivKeyArmor: string(cryptoamino.MarshalPrivKey(privKey)),
+			Algo:         "secp256k1",
+		}))
+	}
+	bz, err := Backup(src, "backup", func(string) string { return "12345678" })
+	require.NoError(t, err)
+
+	// the keys restored before the failure are deleted
+	dst := NewMemory(nil)
+	_, err = Restore(failingDAO{KeyDAO: dst, fail: "bob"}, bz, "backup", nil)
+	require.Error(t, err)
+	infos, err := dst.List()
+	require.NoError(t, err)
+	require.Empty(t, infos)
+
+	// nothing is written if a key exists
+	require.NoError(t, dst.Write("carol", "12345678", KeyInfo{Name: "carol", Type: TypeOffline}))
+	_, err = Restore(dst, bz, "backup", nil)
+	require.Error(t, err)
+	infos, err = dst.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+
+	names, err := Restore(NewMemory(nil), bz, "backup", nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice", "bob", "carol"}, names)
+}
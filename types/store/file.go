@@ -1,194 +1,445 @@
 package store
 
-//
-//import (
-//	"encoding/json"
-//	"fmt"
-//	"io/ioutil"
-//	"os"
-//	"path/filepath"
-//	"strings"
-//	"time"
-//
-//	"github.com/99designs/keyring"
-//	jose "github.com/dvsekhvalnov/jose2go"
-//	"github.com/mitchellh/go-homedir"
-//	"github.com/mtibben/percent"
-//	"github.com/pkg/errors"
-//
-//	cryptoamino "github.com/irisnet/irishub-sdk-go/crypto/codec"
-//	"github.com/irisnet/irishub-sdk-go/crypto/hd"
-//)
-//
-//const (
-//	keyringFileDirName = "keyring-file"
-//)
-//
-//var (
-//	_ KeyDAO = FileDAO{}
-//
-//	filenameEscape = func(s string) string {
-//		return percent.Encode(s, "/")
-//	}
-//)
-//
-////Execute the local file system to realize the persistence of the key data, and the stored data is encrypted using `PBES2`.
-////Can directly read the data of `iris` keys (--keyring-backend = file)
-//type FileDAO struct {
-//	dir string
-//}
-//
-//func NewFileDAO(dir string) KeyDAO {
-//	fileDir := filepath.Join(dir, keyringFileDirName)
-//	return &FileDAO{dir: fileDir}
-//}
-//
-//// Write will use user password to encrypt data and save to file, the file name is user name
-//func (f FileDAO) Write(name, password string, info KeyInfo) error {
-//	pubkey, err := PubKeyFromBytes(info.PubKey)
-//	if err != nil {
-//		return err
-//	}
-//
-//	lInfo := localInfo{
-//		Name:         info.Name,
-//		PubKey:       pubkey,
-//		PrivKeyArmor: info.PrivKeyArmor,
-//		Algo:         hd.PubKeyType(info.Algo),
-//	}
-//
-//	item := keyring.Item{
-//		Key:  name,
-//		Data: marshalInfo(lInfo),
-//	}
-//
-//	bytes, err := json.Marshal(item)
-//	if err != nil {
-//		return err
-//	}
-//
-//	token, err := jose.Encrypt(
-//		string(bytes), jose.PBES2_HS256_A128KW, jose.A256GCM, password,
-//		jose.Headers(map[string]interface{}{"created": time.Now().String()}),
-//	)
-//	if err != nil {
-//		return err
-//	}
-//
-//	filename, err := f.filename(item.Key)
-//	if err != nil {
-//		return err
-//	}
-//
-//	return ioutil.WriteFile(filename, []byte(token), 0600)
-//}
-//
-//// Read will read encrypted data from file and decrypt with user password
-//func (f FileDAO) Read(name, password string) (KeyInfo, error) {
-//	filename, err := f.filename(name)
-//	if err != nil {
-//		return KeyInfo{}, err
-//	}
-//
-//	if len(password) == 0 {
-//		return KeyInfo{}, fmt.Errorf("no password")
-//	}
-//
-//	bytes, err := ioutil.ReadFile(filename)
-//	if err != nil {
-//		return KeyInfo{}, errors.Wrap(err, "not found")
-//	}
-//
-//	payload, _, err := jose.Decode(string(bytes), password)
-//	if err != nil {
-//		return KeyInfo{}, err
-//	}
-//
-//	var decoded keyring.Item
-//	err = json.Unmarshal([]byte(payload), &decoded)
-//	if err != nil {
-//		return KeyInfo{}, err
-//	}
-//
-//	info, err := unmarshalInfo(decoded.Data)
-//	if err != nil {
-//		return KeyInfo{}, err
-//	}
-//
-//	i, ok := info.(localInfo)
-//	if !ok {
-//		return KeyInfo{}, fmt.Errorf("only support type KeyInfo")
-//	}
-//
-//	return KeyInfo{
-//		Name:         i.Name,
-//		PubKey:       cryptoamino.MarshalPubkey(i.PubKey),
-//		PrivKeyArmor: i.PrivKeyArmor,
-//		Algo:         string(i.Algo),
-//	}, nil
-//}
-//
-//// Delete will delete user data and use user password to verify permissions
-//func (f FileDAO) Delete(name, password string) error {
-//	//Perform security verification
-//	if _, err := f.Read(name, password); err != nil {
-//		return err
-//	}
-//
-//	filename, err := f.filename(name)
-//	if err != nil {
-//		return err
-//	}
-//
-//	return os.Remove(filename)
-//}
-//
-//// Has returns whether the specified user name exists
-//func (f FileDAO) Has(name string) bool {
-//	filename, err := f.filename(name)
-//	if err != nil {
-//		return false
-//	}
-//	if _, err = os.Stat(filename); err == nil {
-//		return true
-//	}
-//	if os.IsNotExist(err) {
-//		return false
-//	}
-//	return false
-//}
-//
-//func (f *FileDAO) filename(key string) (string, error) {
-//	dir, err := f.resolveDir()
-//	if err != nil {
-//		return "", err
-//	}
-//
-//	return filepath.Join(dir, filenameEscape(string(infoKey(key)))), nil
-//}
-//
-//func (f *FileDAO) resolveDir() (string, error) {
-//	if f.dir == "" {
-//		return "", fmt.Errorf("no directory provided for file keyring")
-//	}
-//
-//	dir := f.dir
-//
-//	// expand tilde for home directory
-//	if strings.HasPrefix(dir, "~") {
-//		home, err := homedir.Dir()
-//		if err != nil {
-//			return "", err
-//		}
-//		dir = strings.Replace(dir, "~", home, 1)
-//	}
-//
-//	stat, err := os.Stat(dir)
-//	if os.IsNotExist(err) {
-//		err = os.MkdirAll(dir, 0700)
-//	} else if err != nil && !stat.IsDir() {
-//		err = fmt.Errorf("%s is a file, not a directory", dir)
-//	}
-//
-//	return dir, err
-//}
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	jose "github.com/dvsekhvalnov/jose2go"
+	"github.com/gofrs/flock"
+	"github.com/mtibben/percent"
+	"github.com/pkg/errors"
+
+	cryptoamino "github.com/irisnet/irishub-sdk-go/crypto/codec"
+	"github.com/irisnet/irishub-sdk-go/crypto/hd"
+	kmultisig "github.com/irisnet/irishub-sdk-go/crypto/keys/multisig"
+)
+
+const (
+	keyringFileDirName = "keyring-file"
+	addressSuffix      = "address"
+	lockFileName       = ".lock"
+
+	// header names of the metadata readable without the password
+	headerCreated = "created"
+	headerName    = "name"
+	headerPubKey  = "pubkey"
+	headerAlgo    = "algo"
+	headerHDPath  = "hd_path"
+	headerType    = "type"
+
+	// createdLayout is the layout of time.Time.String() written by the cosmos keyring
+	createdLayout = "2006-01-02 15:04:05.999999999 -0700 MST"
+)
+
+var (
+	_ KeyDAO = FileDAO{}
+
+	filenameEscape = func(s string) string {
+		return percent.Encode(s, "/")
+	}
+	filenameUnescape = percent.Decode
+)
+
+// keyringItem is the item of the `file` backend of github.com/99designs/keyring
+type keyringItem struct {
+	Key         string
+	Data        []byte
+	Label       string
+	Description string
+}
+
+// FileDAO executes the local file system to realize the persistence of the key data, every key is a file
+// encrypted by its password using `PBES2`, including the watch-only and multisig keys. The files have the layout
+// of the `file` keyring backend of cosmos-sdk, so it can directly read and write the keys of
+// `iris keys --keyring-backend file` as long as all keys are encrypted by the password of that keyring.
+type FileDAO struct {
+	dir string
+}
+
+// NewFileDAO initialize a keybase in the directory `keyring-file` of rootDir
+func NewFileDAO(rootDir string) (KeyDAO, error) {
+	if strings.HasPrefix(rootDir, "~") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		rootDir = strings.Replace(rootDir, "~", home, 1)
+	}
+
+	dir := filepath.Join(rootDir, keyringFileDirName)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return FileDAO{dir: dir}, nil
+}
+
+// Write will use user password to encrypt data and save to file, the file name is user name
+func (f FileDAO) Write(name, password string, info KeyInfo) error {
+	if len(password) == 0 {
+		return fmt.Errorf("no password")
+	}
+
+	lock, err := f.lock()
+	if err != nil {
+		return err
+	}
+	defer lock.Unlock()
+
+	if f.Has(name) {
+		return fmt.Errorf("name %s has exist", name)
+	}
+	return f.write(name, password, info)
+}
+
+// ChangePassword will decrypt the key with the old password and replace its files encrypted by the new password
+func (f FileDAO) ChangePassword(name, oldPassword, newPassword string) error {
+	if len(newPassword) == 0 {
+		return fmt.Errorf("no password")
+	}
+
+	info, err := f.Read(name, oldPassword)
+	if err != nil {
+		return err
+	}
+	if info.Type != TypeLocal {
+		return fmt.Errorf("the %s key %s has no password", info.Type, name)
+	}
+
+	lock, err := f.lock()
+	if err != nil {
+		return err
+	}
+	defer lock.Unlock()
+
+	return f.write(name, newPassword, info)
+}
+
+// write encrypts the key and its address index with the password
+func (f FileDAO) write(name, password string, info KeyInfo) error {
+	lInfo, err := newInfo(name, info)
+	if err != nil {
+		return err
+	}
+
+	if info.CreatedAt.IsZero() {
+		info.CreatedAt = time.Now().UTC()
+	}
+	headers := map[string]interface{}{
+		headerCreated: info.CreatedAt.String(),
+		headerName:    name,
+		headerPubKey:  hex.EncodeToString(info.PubKey),
+		headerAlgo:    info.Algo,
+		headerHDPath:  info.HDPath,
+		headerType:    info.Type.String(),
+	}
+
+	if err := f.writeItem(keyringItem{Key: addressKey(lInfo.GetPubKey().Address()), Data: infoKey(name)}, password, headers); err != nil {
+		return err
+	}
+	return f.writeItem(keyringItem{Key: string(infoKey(name)), Data: marshalInfo(lInfo)}, password, headers)
+}
+
+// Read will read encrypted data from file and decrypt with user password, the type of the key
+// is the one of the decrypted entry rather than the one of the headers
+func (f FileDAO) Read(name, password string) (KeyInfo, error) {
+	if len(password) == 0 {
+		return KeyInfo{}, fmt.Errorf("no password")
+	}
+
+	lock, err := f.rlock()
+	if err != nil {
+		return KeyInfo{}, err
+	}
+	defer lock.Unlock()
+
+	token, err := ioutil.ReadFile(f.filename(string(infoKey(name))))
+	if err != nil {
+		return KeyInfo{}, errors.Wrap(err, "not found")
+	}
+
+	payload, headers, err := jose.Decode(string(token), password)
+	if err != nil {
+		return KeyInfo{}, err
+	}
+
+	var item keyringItem
+	if err := json.Unmarshal([]byte(payload), &item); err != nil {
+		return KeyInfo{}, err
+	}
+
+	info, err := unmarshalInfo(item.Data)
+	if err != nil {
+		return KeyInfo{}, err
+	}
+
+	keyInfo := KeyInfo{
+		Name:      info.GetName(),
+		PubKey:    cryptoamino.MarshalPubkey(info.GetPubKey()),
+		Algo:      string(info.GetAlgo()),
+		Type:      info.GetType(),
+		CreatedAt: parseCreated(headers),
+	}
+	if i, ok := info.(localInfo); ok {
+		keyInfo.PrivKeyArmor = i.PrivKeyArmor
+	}
+	if path, err := info.GetPath(); err == nil {
+		keyInfo.HDPath = path.String()
+	}
+	return keyInfo, nil
+}
+
+// ReadMetadata read the key information from the unencrypted header of the file, the public key
+// is not available for the keys written by the cosmos keyring
+func (f FileDAO) ReadMetadata(name string) (KeyInfo, error) {
+	lock, err := f.rlock()
+	if err != nil {
+		return KeyInfo{}, err
+	}
+	defer lock.Unlock()
+
+	return f.readMetadata(string(infoKey(name)))
+}
+
+// List returns the information of all keys sorted by name
+func (f FileDAO) List() ([]KeyInfo, error) {
+	lock, err := f.rlock()
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Unlock()
+
+	files, err := ioutil.ReadDir(f.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var infos []KeyInfo
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+
+		key := filenameUnescape(file.Name())
+		if !strings.HasSuffix(key, "."+infoSuffix) {
+			continue
+		}
+
+		info, err := f.readMetadata(key)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name < infos[j].Name
+	})
+	return infos, nil
+}
+
+// Delete will delete user data and use user password to verify permissions
+func (f FileDAO) Delete(name, password string) error {
+	//Perform security verification
+	info, err := f.Read(name, password)
+	if err != nil {
+		return err
+	}
+
+	lock, err := f.lock()
+	if err != nil {
+		return err
+	}
+	defer lock.Unlock()
+
+	pubKey, err := cryptoamino.PubKeyFromBytes(info.PubKey)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(f.filename(addressKey(pubKey.Address()))); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return os.Remove(f.filename(string(infoKey(name))))
+}
+
+// Has returns whether the specified user name exists
+func (f FileDAO) Has(name string) bool {
+	_, err := os.Stat(f.filename(string(infoKey(name))))
+	return err == nil
+}
+
+// writeItem encrypts the item and replaces its file atomically
+func (f FileDAO) writeItem(item keyringItem, password string, headers map[string]interface{}) error {
+	bz, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+
+	token, err := jose.Encrypt(
+		string(bz), jose.PBES2_HS256_A128KW, jose.A256GCM, password,
+		jose.Headers(headers),
+	)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(f.dir, ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(0600); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if _, err := tmp.WriteString(token); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.filename(item.Key))
+}
+
+func (f FileDAO) readMetadata(key string) (KeyInfo, error) {
+	token, err := ioutil.ReadFile(f.filename(key))
+	if err != nil {
+		return KeyInfo{}, errors.Wrap(err, "not found")
+	}
+
+	headers, err := decodeHeaders(string(token))
+	if err != nil {
+		return KeyInfo{}, err
+	}
+
+	info := KeyInfo{
+		Name:      strings.TrimSuffix(key, "."+infoSuffix),
+		Type:      keyTypeFromString(headerString(headers, headerType)),
+		Algo:      headerString(headers, headerAlgo),
+		HDPath:    headerString(headers, headerHDPath),
+		CreatedAt: parseCreated(headers),
+	}
+	if pubKey := headerString(headers, headerPubKey); len(pubKey) > 0 {
+		if info.PubKey, err = hex.DecodeString(pubKey); err != nil {
+			return KeyInfo{}, err
+		}
+	}
+	return info, nil
+}
+
+func (f FileDAO) filename(key string) string {
+	return filepath.Join(f.dir, filenameEscape(key))
+}
+
+func (f FileDAO) lock() (*flock.Flock, error) {
+	lock := flock.New(filepath.Join(f.dir, lockFileName))
+	return lock, lock.Lock()
+}
+
+func (f FileDAO) rlock() (*flock.Flock, error) {
+	lock := flock.New(filepath.Join(f.dir, lockFileName))
+	return lock, lock.RLock()
+}
+
+// newInfo returns the amino Info of the key with the type of the cosmos keyring
+func newInfo(name string, info KeyInfo) (Info, error) {
+	pubKey, err := cryptoamino.PubKeyFromBytes(info.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	switch info.Type {
+	case TypeLocal:
+		lInfo := localInfo{
+			Name:         name,
+			PubKey:       pubKey,
+			PrivKeyArmor: info.PrivKeyArmor,
+			Algo:         hd.PubKeyType(info.Algo),
+		}
+		if len(info.HDPath) > 0 {
+			if lInfo.Path, err = hd.NewParamsFromPath(info.HDPath); err != nil {
+				return nil, err
+			}
+		}
+		return lInfo, nil
+	case TypeOffline:
+		return offlineInfo{
+			Name:   name,
+			PubKey: pubKey,
+			Algo:   hd.PubKeyType(info.Algo),
+		}, nil
+	case TypeMulti:
+		multisigPubKey, ok := pubKey.(*kmultisig.LegacyAminoPubKey)
+		if !ok {
+			return nil, fmt.Errorf("the public key of %s is not a multisig public key", name)
+		}
+
+		mInfo := multiInfo{
+			Name:      name,
+			PubKey:    pubKey,
+			Threshold: uint(multisigPubKey.Threshold),
+		}
+		for _, member := range multisigPubKey.GetPubKeys() {
+			mInfo.PubKeys = append(mInfo.PubKeys, multisigPubKeyInfo{PubKey: member, Weight: 1})
+		}
+		return mInfo, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %d", info.Type)
+	}
+}
+
+func addressKey(address []byte) string {
+	return fmt.Sprintf("%s.%s", hex.EncodeToString(address), addressSuffix)
+}
+
+// decodeHeaders returns the unencrypted headers of a compact JOSE token
+func decodeHeaders(token string) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("invalid token with %d parts", len(parts))
+	}
+
+	bz, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var headers map[string]interface{}
+	if err := json.Unmarshal(bz, &headers); err != nil {
+		return nil, err
+	}
+	return headers, nil
+}
+
+func headerString(headers map[string]interface{}, name string) string {
+	value, _ := headers[name].(string)
+	return value
+}
+
+func parseCreated(headers map[string]interface{}) time.Time {
+	created := headerString(headers, headerCreated)
+	// strip the monotonic clock reading of time.Now().String()
+	if i := strings.Index(created, " m="); i >= 0 {
+		created = created[:i]
+	}
+
+	t, err := time.Parse(createdLayout, created)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
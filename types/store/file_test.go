@@ -0,0 +1,202 @@
+package store
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	jose "github.com/dvsekhvalnov/jose2go"
+	"github.com/stretchr/testify/require"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+
+	cryptoamino "github.com/irisnet/irishub-sdk-go/crypto/codec"
+	"github.com/irisnet/irishub-sdk-go/crypto/hd"
+	kmultisig "github.com/irisnet/irishub-sdk-go/crypto/keys/multisig"
+	"github.com/irisnet/irishub-sdk-go/crypto/keys/secp256k1"
+	"github.com/irisnet/irishub-sdk-go/crypto/keys/sm2"
+)
+
+func TestFileDAO(t *testing.T) {
+	root := t.TempDir()
+	dao, err := NewFileDAO(root)
+	require.NoError(t, err)
+
+	privKey := secp256k1.GenPrivKey()
+	info := KeyInfo{
+		Name:         "alice",
+		PubKey:       cryptoamino.MarshalPubkey(privKey.PubKey()),
+		PrivKeyArmor: string(cryptoamino.MarshalPrivKey(privKey)),
+		Algo:         "secp256k1",
+		CreatedAt:    time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC),
+		HDPath:       "44'/118'/0'/0/1",
+	}
+	require.NoError(t, dao.Write("alice", "12345678", info))
+	require.Error(t, dao.Write("alice", "12345678", info))
+	require.True(t, dao.Has("alice"))
+
+	stat, err := os.Stat(filepath.Join(root, keyringFileDirName, "alice.info"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), stat.Mode().Perm())
+
+	read, err := dao.Read("alice", "12345678")
+	require.NoError(t, err)
+	require.Equal(t, info, read)
+
+	_, err = dao.Read("alice", "87654321")
+	require.Error(t, err)
+
+	// the metadata is readable without the password
+	metadata, err := dao.ReadMetadata("alice")
+	require.NoError(t, err)
+	info.PrivKeyArmor = ""
+	require.Equal(t, info, metadata)
+
+	infos, err := dao.List()
+	require.NoError(t, err)
+	require.Equal(t, []KeyInfo{info}, infos)
+
+	require.Error(t, dao.ChangePassword("alice", "87654321", "abcdefgh"))
+	require.NoError(t, dao.ChangePassword("alice", "12345678", "abcdefgh"))
+	_, err = dao.Read("alice", "12345678")
+	require.Error(t, err)
+	read, err = dao.Read("alice", "abcdefgh")
+	require.NoError(t, err)
+	require.Equal(t, info.CreatedAt, read.CreatedAt)
+
+	require.Error(t, dao.Delete("alice", "87654321"))
+	require.NoError(t, dao.Delete("alice", "abcdefgh"))
+	require.False(t, dao.Has("alice"))
+
+	files, err := ioutil.ReadDir(filepath.Join(root, keyringFileDirName))
+	require.NoError(t, err)
+	for _, file := range files {
+		require.Equal(t, lockFileName, file.Name())
+	}
+}
+
+// TestFileDAOCosmosKeyring reads a key written by the `file` keyring backend of cosmos-sdk
+func TestFileDAOCosmosKeyring(t *testing.T) {
+	root := t.TempDir()
+	dao, err := NewFileDAO(root)
+	require.NoError(t, err)
+
+	privKey := secp256k1.GenPrivKey()
+	lInfo := localInfo{
+		Name:         "bob",
+		PubKey:       privKey.PubKey(),
+		PrivKeyArmor: string(cryptoamino.MarshalPrivKey(privKey)),
+		Algo:         hd.Secp256k1Type,
+	}
+	bz, err := json.Marshal(keyringItem{Key: "bob.info", Data: marshalInfo(lInfo)})
+	require.NoError(t, err)
+
+	created := time.Now()
+	token, err := jose.Encrypt(string(bz), jose.PBES2_HS256_A128KW, jose.A256GCM, "12345678",
+		jose.Headers(map[string]interface{}{"created": created.String()}),
+	)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(root, keyringFileDirName, "bob.info"), []byte(token), 0600))
+
+	info, err := dao.Read("bob", "12345678")
+	require.NoError(t, err)
+	require.Equal(t, lInfo.PrivKeyArmor, info.PrivKeyArmor)
+	require.Equal(t, cryptoamino.MarshalPubkey(privKey.PubKey()), info.PubKey)
+	require.True(t, created.Round(0).Equal(info.CreatedAt))
+
+	infos, err := dao.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	require.Equal(t, "bob", infos[0].Name)
+	require.Empty(t, infos[0].PubKey)
+}
+
+// TestFileDAOCosmosKeyringList reads a directory of local, watch-only and multisig keys as the `file`
+// keyring backend of cosmos-sdk does for `iris keys list`, every entry is decrypted by the keyring password
+func TestFileDAOCosmosKeyringList(t *testing.T) {
+	root := t.TempDir()
+	dao, err := NewFileDAO(root)
+	require.NoError(t, err)
+
+	local, member := secp256k1.GenPrivKey(), sm2.GenPrivKey()
+	multisigPubKey := kmultisig.NewLegacyAminoPubKey(1, []tmcrypto.PubKey{local.PubKey(), member.PubKey()})
+	infos := []KeyInfo{{
+		Name:         "alice",
+		PubKey:       cryptoamino.MarshalPubkey(local.PubKey()),
+		PrivKeyArmor: string(cryptoamino.MarshalPrivKey(local)),
+		Algo:         "secp256k1",
+	}, {
+		Name:   "multi",
+		PubKey: cryptoamino.MarshalPubkey(multisigPubKey),
+		Algo:   "multi",
+		Type:   TypeMulti,
+	}, {
+		Name:   "watch",
+		PubKey: cryptoamino.MarshalPubkey(member.PubKey()),
+		Algo:   "sm2",
+		Type:   TypeOffline,
+	}}
+	for _, info := range infos {
+		require.Error(t, dao.Write(info.Name, "", info))
+		require.NoError(t, dao.Write(info.Name, "12345678", info))
+	}
+
+	dir := filepath.Join(root, keyringFileDirName)
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+
+	var listed []Info
+	for _, file := range files {
+		key := filenameUnescape(file.Name())
+		if file.Name() == lockFileName {
+			continue
+		}
+
+		token, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		require.NoError(t, err)
+		payload, _, err := jose.Decode(string(token), "12345678")
+		require.NoError(t, err, key)
+
+		var item keyringItem
+		require.NoError(t, json.Unmarshal([]byte(payload), &item))
+		require.Equal(t, key, item.Key)
+		if strings.HasSuffix(key, "."+infoSuffix) {
+			info, err := unmarshalInfo(item.Data)
+			require.NoError(t, err)
+			listed = append(listed, info)
+		}
+	}
+
+	require.Len(t, listed, len(infos))
+	for i, info := range infos {
+		require.Equal(t, info.Name, listed[i].GetName())
+		require.Equal(t, info.Type, listed[i].GetType())
+		require.Equal(t, info.PubKey, cryptoamino.MarshalPubkey(listed[i].GetPubKey()))
+	}
+
+	// the public entries are decrypted by the password as well
+	_, err = dao.Read("watch", "")
+	require.Error(t, err)
+	_, err = dao.Read("watch", "87654321")
+	require.Error(t, err)
+
+	// the type is taken from the decrypted entry, an edited header fails the decryption
+	path := filepath.Join(dir, "watch.info")
+	token, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	parts := strings.Split(string(token), ".")
+	headers, err := decodeHeaders(string(token))
+	require.NoError(t, err)
+	headers[headerType] = TypeLocal.String()
+	bz, err := json.Marshal(headers)
+	require.NoError(t, err)
+	parts[0] = base64.RawURLEncoding.EncodeToString(bz)
+	require.NoError(t, ioutil.WriteFile(path, []byte(strings.Join(parts, ".")), 0600))
+
+	_, err = dao.Read("watch", "12345678")
+	require.Error(t, err)
+}
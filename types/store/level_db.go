@@ -1,11 +1,16 @@
 package store
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	dbm "github.com/tendermint/tm-db"
+
+	cryptoamino "github.com/irisnet/irishub-sdk-go/crypto/codec"
 )
 
 const (
@@ -31,7 +36,7 @@ func NewLevelDB(rootDir string, crypto Crypto) (KeyDAO, error) {
 	}
 
 	if crypto == nil {
-		crypto = AES{}
+		crypto = Scrypt{}
 	}
 
 	levelDB := LevelDBDAO{
@@ -46,22 +51,11 @@ func (k LevelDBDAO) Write(name, password string, info KeyInfo) error {
 	if k.Has(name) {
 		return fmt.Errorf("name %s has exist", name)
 	}
-
-	privStr, err := k.Encrypt(info.PrivKeyArmor, password)
-	if err != nil {
-		return err
-	}
-
-	info.PrivKeyArmor = privStr
-
-	bz, err := json.Marshal(info)
-	if err != nil {
-		return err
-	}
-	return k.db.SetSync(infoKey(name), bz)
+	return k.write(name, password, info)
 }
 
-// Read read a key information from the local store
+// Read read a key information from the local store, the key encrypted by a legacy scheme
+// is encrypted again with the current scheme of the Crypto
 func (k LevelDBDAO) Read(name, password string) (store KeyInfo, err error) {
 	bz, err := k.db.Get(infoKey(name))
 	if bz == nil || err != nil {
@@ -72,16 +66,62 @@ func (k LevelDBDAO) Read(name, password string) (store KeyInfo, err error) {
 		return store, err
 	}
 
-	if len(password) > 0 {
-		privStr, err := k.Decrypt(store.PrivKeyArmor, password)
+	// the watch-only and multisig entries have no private key
+	if len(password) > 0 && store.Type == TypeLocal {
+		cipherText := store.PrivKeyArmor
+		privStr, err := k.Decrypt(cipherText, password)
 		if err != nil {
 			return store, err
 		}
 		store.PrivKeyArmor = privStr
+
+		if u, ok := k.Crypto.(Upgradable); ok && u.IsLegacy(cipherText) {
+			// the legacy scheme is not authenticated, a wrong password is only detected by the key itself
+			if err := checkPrivKey(store); err != nil {
+				return store, fmt.Errorf("invalid password")
+			}
+			// a failed migration is retried on the next read
+			_ = k.write(name, password, store)
+		}
 	}
 	return
 }
 
+// ChangePassword encrypts the key with the new password
+func (k LevelDBDAO) ChangePassword(name, oldPassword, newPassword string) error {
+	if !k.Has(name) {
+		return fmt.Errorf("name %s not exist", name)
+	}
+
+	info, err := k.Read(name, oldPassword)
+	if err != nil {
+		return err
+	}
+	if info.Type != TypeLocal {
+		return fmt.Errorf("the %s key %s has no password", info.Type, name)
+	}
+	if err := checkPrivKey(info); err != nil {
+		return fmt.Errorf("invalid password")
+	}
+	return k.write(name, newPassword, info)
+}
+
+func (k LevelDBDAO) write(name, password string, info KeyInfo) error {
+	if info.Type == TypeLocal {
+		privStr, err := k.Encrypt(info.PrivKeyArmor, password)
+		if err != nil {
+			return err
+		}
+		info.PrivKeyArmor = privStr
+	}
+
+	bz, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return k.db.SetSync(infoKey(name), bz)
+}
+
 // ReadMetadata read a key information from the local store
 func (k LevelDBDAO) ReadMetadata(name string) (store KeyInfo, err error) {
 	bz, err := k.db.Get(infoKey(name))
@@ -104,6 +144,37 @@ func (k LevelDBDAO) Delete(name, password string) error {
 	return k.db.DeleteSync(infoKey(name))
 }
 
+// List returns the information of all keys sorted by name
+func (k LevelDBDAO) List() ([]KeyInfo, error) {
+	iter, err := k.db.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var infos []KeyInfo
+	for ; iter.Valid(); iter.Next() {
+		if !strings.HasSuffix(string(iter.Key()), "."+infoSuffix) {
+			continue
+		}
+
+		var info KeyInfo
+		if err := json.Unmarshal(iter.Value(), &info); err != nil {
+			return nil, err
+		}
+		info.PrivKeyArmor = ""
+		infos = append(infos, info)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name < infos[j].Name
+	})
+	return infos, nil
+}
+
 // Delete delete a key from the local store
 func (k LevelDBDAO) Has(name string) bool {
 	existed, err := k.db.Has(infoKey(name))
@@ -113,6 +184,18 @@ func (k LevelDBDAO) Has(name string) bool {
 	return existed
 }
 
+// checkPrivKey returns an error if the private key of the information is not the one of its public key
+func checkPrivKey(info KeyInfo) error {
+	privKey, err := cryptoamino.PrivKeyFromBytes([]byte(info.PrivKeyArmor))
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(cryptoamino.MarshalPubkey(privKey.PubKey()), info.PubKey) {
+		return fmt.Errorf("the private key does not match the public key")
+	}
+	return nil
+}
+
 func infoKey(name string) []byte {
 	return []byte(fmt.Sprintf("%s.%s", name, infoSuffix))
 }
@@ -0,0 +1,191 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	dbm "github.com/tendermint/tm-db"
+
+	cryptoamino "github.com/irisnet/irishub-sdk-go/crypto/codec"
+	kmultisig "github.com/irisnet/irishub-sdk-go/crypto/keys/multisig"
+	"github.com/irisnet/irishub-sdk-go/crypto/keys/secp256k1"
+	"github.com/irisnet/irishub-sdk-go/crypto/keys/sm2"
+)
+
+func TestList(t *testing.T) {
+	levelDB, err := NewLevelDB(t.TempDir(), nil)
+	require.NoError(t, err)
+
+	for _, dao := range []KeyDAO{NewMemory(nil), levelDB} {
+		for _, name := range []string{"bob", "alice", "a-b"} {
+			require.NoError(t, dao.Write(name, "12345678", KeyInfo{
+				Name:         name,
+				PrivKeyArmor: "armor",
+				Algo:         "secp256k1",
+			}))
+		}
+
+		infos, err := dao.List()
+		require.NoError(t, err)
+		require.Len(t, infos, 3)
+		for i, name := range []string{"a-b", "alice", "bob"} {
+			require.Equal(t, name, infos[i].Name)
+			require.Equal(t, "secp256k1", infos[i].Algo)
+			require.Empty(t, infos[i].PrivKeyArmor)
+		}
+
+		info, err := dao.ReadMetadata("alice")
+		require.NoError(t, err)
+		require.Equal(t, "alice", info.Name)
+	}
+}
+
+func TestMemoryChangePassword(t *testing.T) {
+	dao := NewMemory(nil)
+	privKey := secp256k1.GenPrivKey()
+	info := KeyInfo{
+		Name:         "alice",
+		PubKey:       cryptoamino.MarshalPubkey(privKey.PubKey()),
+		PrivKeyArmor: string(cryptoamino.MarshalPrivKey(privKey)),
+		Algo:         "secp256k1",
+		Type:         TypeLocal,
+	}
+	require.NoError(t, dao.Write("alice", "12345678", info))
+
+	// the key is kept encrypted
+	stored, err := dao.ReadMetadata("alice")
+	require.NoError(t, err)
+	require.NotEqual(t, info.PrivKeyArmor, stored.PrivKeyArmor)
+
+	_, err = dao.Read("alice", "87654321")
+	require.Error(t, err)
+	require.Error(t, dao.Delete("alice", "87654321"))
+
+	require.Error(t, dao.ChangePassword("alice", "87654321", "abcdefgh"))
+	require.NoError(t, dao.ChangePassword("alice", "12345678", "abcdefgh"))
+	require.Error(t, dao.ChangePassword("bob", "12345678", "abcdefgh"))
+
+	_, err = dao.Read("alice", "12345678")
+	require.Error(t, err)
+	read, err := dao.Read("alice", "abcdefgh")
+	require.NoError(t, err)
+	require.Equal(t, info.PrivKeyArmor, read.PrivKeyArmor)
+	require.NoError(t, dao.Delete("alice", "abcdefgh"))
+	require.False(t, dao.Has("alice"))
+}
+
+func TestScrypt(t *testing.T) {
+	cipherText, err := Scrypt{}.Encrypt("armor", "12345678")
+	require.NoError(t, err)
+	require.False(t, Scrypt{}.IsLegacy(cipherText))
+
+	// every encryption uses a new salt
+	another, err := Scrypt{}.Encrypt("armor", "12345678")
+	require.NoError(t, err)
+	require.NotEqual(t, cipherText, another)
+
+	plainText, err := Scrypt{}.Decrypt(cipherText, "12345678")
+	require.NoError(t, err)
+	require.Equal(t, "armor", plainText)
+
+	_, err = Scrypt{}.Decrypt(cipherText, "87654321")
+	require.Error(t, err)
+
+	legacy, err := AES{}.Encrypt("armor", "12345678")
+	require.NoError(t, err)
+	require.True(t, Scrypt{}.IsLegacy(legacy))
+
+	plainText, err = Scrypt{}.Decrypt(legacy, "12345678")
+	require.NoError(t, err)
+	require.Equal(t, "armor", plainText)
+}
+
+func TestLevelDBMigration(t *testing.T) {
+	db := dbm.NewMemDB()
+	privKey := secp256k1.GenPrivKey()
+	info := KeyInfo{
+		Name:         "alice",
+		PubKey:       cryptoamino.MarshalPubkey(privKey.PubKey()),
+		PrivKeyArmor: string(cryptoamino.MarshalPrivKey(privKey)),
+		Algo:         "secp256k1",
+	}
+
+	// written by the older versions
+	require.NoError(t, LevelDBDAO{db: db, Crypto: AES{}}.Write("alice", "12345678", info))
+
+	dao := LevelDBDAO{db: db, Crypto: Scrypt{}}
+	_, err := dao.Read("alice", "87654321")
+	require.Error(t, err)
+
+	read, err := dao.Read("alice", "12345678")
+	require.NoError(t, err)
+	require.Equal(t, info.PrivKeyArmor, read.PrivKeyArmor)
+
+	stored, err := dao.ReadMetadata("alice")
+	require.NoError(t, err)
+	require.False(t, Scrypt{}.IsLegacy(stored.PrivKeyArmor))
+
+	require.Error(t, dao.ChangePassword("alice", "87654321", "abcdefgh"))
+	require.NoError(t, dao.ChangePassword("alice", "12345678", "abcdefgh"))
+
+	_, err = dao.Read("alice", "12345678")
+	require.Error(t, err)
+	read, err = dao.Read("alice", "abcdefgh")
+	require.NoError(t, err)
+	require.Equal(t, info.PrivKeyArmor, read.PrivKeyArmor)
+}
+
+func TestPublicKeys(t *testing.T) {
+	levelDB, err := NewLevelDB(t.TempDir(), nil)
+	require.NoError(t, err)
+	fileDAO, err := NewFileDAO(t.TempDir())
+	require.NoError(t, err)
+
+	members := []tmcrypto.PubKey{
+		secp256k1.GenPrivKey().PubKey(),
+		sm2.GenPrivKey().PubKey(),
+		secp256k1.GenPrivKey().PubKey(),
+	}
+	multisigPubKey := kmultisig.NewLegacyAminoPubKey(2, members)
+
+	for _, dao := range []KeyDAO{NewMemory(nil), levelDB, fileDAO} {
+		offline := KeyInfo{
+			Name:   "watch",
+			PubKey: cryptoamino.MarshalPubkey(members[0]),
+			Algo:   "secp256k1",
+			Type:   TypeOffline,
+		}
+		multi := KeyInfo{
+			Name:   "multi",
+			PubKey: cryptoamino.MarshalPubkey(multisigPubKey),
+			Algo:   "multi",
+			Type:   TypeMulti,
+		}
+		require.NoError(t, dao.Write("watch", "12345678", offline))
+		require.NoError(t, dao.Write("multi", "12345678", multi))
+
+		infos, err := dao.List()
+		require.NoError(t, err)
+		require.Len(t, infos, 2)
+		require.Equal(t, TypeMulti, infos[0].Type)
+		require.Equal(t, TypeOffline, infos[1].Type)
+
+		for _, info := range []KeyInfo{offline, multi} {
+			read, err := dao.Read(info.Name, "12345678")
+			require.NoError(t, err)
+			require.Equal(t, info.Type, read.Type)
+			require.Equal(t, info.PubKey, read.PubKey)
+			require.Empty(t, read.PrivKeyArmor)
+		}
+
+		read, err := dao.Read("multi", "12345678")
+		require.NoError(t, err)
+		pubKey, err := cryptoamino.PubKeyFromBytes(read.PubKey)
+		require.NoError(t, err)
+		require.Equal(t, multisigPubKey.Address(), pubKey.Address())
+		require.Len(t, pubKey.(*kmultisig.LegacyAminoPubKey).GetPubKeys(), 3)
+
+		require.Error(t, dao.ChangePassword("watch", "12345678", "abcdefgh"))
+	}
+}
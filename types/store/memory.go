@@ -1,5 +1,10 @@
 package store
 
+import (
+	"fmt"
+	"sort"
+)
+
 // Use memory as storage, use with caution in build environment
 type MemoryDAO struct {
 	store map[string]KeyInfo
@@ -8,20 +13,38 @@ type MemoryDAO struct {
 
 func NewMemory(crypto Crypto) MemoryDAO {
 	if crypto == nil {
-		crypto = AES{}
+		crypto = Scrypt{}
 	}
 	return MemoryDAO{
 		store:  make(map[string]KeyInfo),
 		Crypto: crypto,
 	}
 }
+// Write add a key information to the memory, the private key is encrypted by the password
 func (m MemoryDAO) Write(name, password string, store KeyInfo) error {
+	if store.Type == TypeLocal {
+		privStr, err := m.Encrypt(store.PrivKeyArmor, password)
+		if err != nil {
+			return err
+		}
+		store.PrivKeyArmor = privStr
+	}
 	m.store[name] = store
 	return nil
 }
 
+// Read read a key information from the memory, the private key is decrypted by the password
 func (m MemoryDAO) Read(name, password string) (KeyInfo, error) {
-	return m.store[name], nil
+	store := m.store[name]
+	// the watch-only and multisig entries have no private key
+	if len(password) > 0 && store.Type == TypeLocal && len(store.PrivKeyArmor) > 0 {
+		privStr, err := m.Decrypt(store.PrivKeyArmor, password)
+		if err != nil {
+			return store, err
+		}
+		store.PrivKeyArmor = privStr
+	}
+	return store, nil
 }
 
 // ReadMetadata read a key information from the local store
@@ -30,11 +53,47 @@ func (m MemoryDAO) ReadMetadata(name string) (store KeyInfo, err error) {
 }
 
 func (m MemoryDAO) Delete(name, password string) error {
+	if _, err := m.Read(name, password); err != nil {
+		return err
+	}
 	delete(m.store, name)
 	return nil
 }
 
+// ChangePassword encrypts the key with the new password
+func (m MemoryDAO) ChangePassword(name, oldPassword, newPassword string) error {
+	if !m.Has(name) {
+		return fmt.Errorf("name %s not exist", name)
+	}
+
+	info, err := m.Read(name, oldPassword)
+	if err != nil {
+		return err
+	}
+	if info.Type != TypeLocal {
+		return fmt.Errorf("the %s key %s has no password", info.Type, name)
+	}
+	if err := checkPrivKey(info); err != nil {
+		return fmt.Errorf("invalid password")
+	}
+	return m.Write(name, newPassword, info)
+}
+
 func (m MemoryDAO) Has(name string) bool {
 	_, ok := m.store[name]
 	return ok
 }
+
+// List returns the information of all keys sorted by name
+func (m MemoryDAO) List() ([]KeyInfo, error) {
+	infos := make([]KeyInfo, 0, len(m.store))
+	for _, info := range m.store {
+		info.PrivKeyArmor = ""
+		infos = append(infos, info)
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name < infos[j].Name
+	})
+	return infos, nil
+}
@@ -0,0 +1,135 @@
+package store
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	scryptVersion = 1
+	scryptPrefix  = "$scrypt$"
+
+	// default cost parameters of scrypt, they are recorded in every ciphertext
+	scryptLogN = 15
+	scryptR    = 8
+	scryptP    = 1
+
+	scryptSaltSize = 16
+	scryptKeySize  = 32
+)
+
+var (
+	_ Crypto     = Scrypt{}
+	_ Upgradable = Scrypt{}
+)
+
+// Upgradable is implemented by a Crypto which can decrypt the data encrypted by a legacy scheme,
+// the KeyDAOs encrypt such data again with the current scheme when it is read
+type Upgradable interface {
+	IsLegacy(data string) bool
+}
+
+// Scrypt encrypts the data with AES-256-GCM using a key derived from the password by scrypt with a
+// random salt. The ciphertext has a versioned header with the scrypt parameters:
+//
+//	$scrypt$v=1$ln=15,r=8,p=1$<salt>$<nonce+ciphertext>
+//
+// The data encrypted by AES is decrypted with AES, so that the keys written by older versions can be migrated.
+type Scrypt struct{}
+
+func (Scrypt) Encrypt(text string, password string) (string, error) {
+	salt := make([]byte, scryptSaltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return "", err
+	}
+
+	gcm, err := newScryptGCM(password, salt, scryptLogN, scryptR, scryptP)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	cipherText := gcm.Seal(nonce, nonce, []byte(text), nil)
+	return fmt.Sprintf("%sv=%d$ln=%d,r=%d,p=%d$%s$%s",
+		scryptPrefix, scryptVersion, scryptLogN, scryptR, scryptP,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(cipherText),
+	), nil
+}
+
+func (s Scrypt) Decrypt(cryptoText string, password string) (string, error) {
+	if s.IsLegacy(cryptoText) {
+		return AES{}.Decrypt(cryptoText, password)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(cryptoText, scryptPrefix), "$")
+	if len(parts) != 4 {
+		return "", fmt.Errorf("invalid scrypt ciphertext")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[0], "v=%d", &version); err != nil || version != scryptVersion {
+		return "", fmt.Errorf("unsupported scrypt version: %s", parts[0])
+	}
+
+	var logN, r, p int
+	if _, err := fmt.Sscanf(parts[1], "ln=%d,r=%d,p=%d", &logN, &r, &p); err != nil {
+		return "", fmt.Errorf("invalid scrypt parameters: %s", parts[1])
+	}
+	if logN <= 0 || logN > 30 {
+		return "", fmt.Errorf("invalid scrypt parameters: %s", parts[1])
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", err
+	}
+	cipherText, err := base64.RawStdEncoding.DecodeString(parts[3])
+	if err != nil {
+		return "", err
+	}
+
+	gcm, err := newScryptGCM(password, salt, logN, r, p)
+	if err != nil {
+		return "", err
+	}
+	if len(cipherText) < gcm.NonceSize() {
+		return "", fmt.Errorf("invalid scrypt ciphertext")
+	}
+
+	nonce, cipherText := cipherText[:gcm.NonceSize()], cipherText[gcm.NonceSize():]
+	plainText, err := gcm.Open(nil, nonce, cipherText, nil)
+	if err != nil {
+		return "", fmt.Errorf("invalid password")
+	}
+	return string(plainText), nil
+}
+
+// IsLegacy returns true if the data is encrypted by AES
+func (Scrypt) IsLegacy(data string) bool {
+	return !strings.HasPrefix(data, scryptPrefix)
+}
+
+func newScryptGCM(password string, salt []byte, logN, r, p int) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(password), salt, 1<<uint(logN), r, p, scryptKeySize)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
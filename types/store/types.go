@@ -2,14 +2,20 @@ package store
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/tendermint/tendermint/crypto"
 
+	codectypes "github.com/irisnet/irishub-sdk-go/codec/types"
 	"github.com/irisnet/irishub-sdk-go/crypto/hd"
 )
 
 var (
 	_ Info = &localInfo{}
+	_ Info = &offlineInfo{}
+	_ Info = &multiInfo{}
+
+	_ codectypes.UnpackInterfacesMessage = multiInfo{}
 )
 
 // KeyType reflects a human-readable type for key listing.
@@ -18,14 +24,44 @@ type KeyType uint
 // Info KeyTypes
 const (
 	TypeLocal KeyType = 0
+	// TypeOffline is a watch-only key without the private key
+	TypeOffline KeyType = 2
+	// TypeMulti is a multisig key of the public keys of its members
+	TypeMulti KeyType = 3
 )
 
+var keyTypes = map[KeyType]string{
+	TypeLocal:   "local",
+	TypeOffline: "offline",
+	TypeMulti:   "multi",
+}
+
+// String implements the stringer interface for KeyType.
+func (kt KeyType) String() string {
+	return keyTypes[kt]
+}
+
+// keyTypeFromString returns the KeyType of its name, TypeLocal if unknown
+func keyTypeFromString(name string) KeyType {
+	for kt, s := range keyTypes {
+		if s == name {
+			return kt
+		}
+	}
+	return TypeLocal
+}
+
 // KeyInfo saves the basic information of the key
 type KeyInfo struct {
-	Name         string `json:"name"`
-	PubKey       []byte `json:"pubkey"`
-	PrivKeyArmor string `json:"priv_key_armor"`
-	Algo         string `json:"algo"`
+	Name         string    `json:"name"`
+	PubKey       []byte    `json:"pubkey"`
+	PrivKeyArmor string    `json:"priv_key_armor"`
+	Algo         string    `json:"algo"`
+	CreatedAt    time.Time `json:"created_at"`
+	// HDPath is the BIP44 path of the key derived from a mnemonic, empty for imported keys
+	HDPath string `json:"hd_path,omitempty"`
+	// Type is the type of the key, only the local keys have the private key
+	Type KeyType `json:"type,omitempty"`
 }
 
 type KeyDAO interface {
@@ -40,6 +76,15 @@ type KeyDAO interface {
 
 	// Has returns whether the specified user name exists
 	Has(name string) bool
+
+	// ChangePassword will decrypt data with the old password and encrypt it with the new password
+	ChangePassword(name, oldPassword, newPassword string) error
+
+	// ReadMetadata will read the key information without decrypting the private key
+	ReadMetadata(name string) (KeyInfo, error)
+
+	// List returns the information of all keys sorted by name, the private keys are not returned
+	List() ([]KeyInfo, error)
 }
 
 type Crypto interface {
@@ -62,12 +107,14 @@ type Info interface {
 }
 
 // localInfo is the public information about a locally stored key
-// Note: Algo must be last field in struct for backwards amino compatibility
+// Note: Algo must follow the cosmos fields in struct for backwards amino compatibility,
+// Path is omitted when it is nil
 type localInfo struct {
-	Name         string        `json:"name"`
-	PubKey       crypto.PubKey `json:"pubkey"`
-	PrivKeyArmor string        `json:"privkey.armor"`
-	Algo         hd.PubKeyType `json:"algo"`
+	Name         string          `json:"name"`
+	PubKey       crypto.PubKey   `json:"pubkey"`
+	PrivKeyArmor string          `json:"privkey.armor"`
+	Algo         hd.PubKeyType   `json:"algo"`
+	Path         *hd.BIP44Params `json:"path,omitempty"`
 }
 
 // GetType implements Info interface
@@ -90,9 +137,87 @@ func (i localInfo) GetAlgo() hd.PubKeyType {
 	return i.Algo
 }
 
-// GetType implements Info interface
+// GetPath implements Info interface
 func (i localInfo) GetPath() (*hd.BIP44Params, error) {
-	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
+	if i.Path == nil {
+		return nil, fmt.Errorf("BIP44 Paths are not available for this key")
+	}
+	return i.Path, nil
+}
+
+// offlineInfo is the public information about a watch-only key
+type offlineInfo struct {
+	Name   string        `json:"name"`
+	PubKey crypto.PubKey `json:"pubkey"`
+	Algo   hd.PubKeyType `json:"algo"`
+}
+
+// GetType implements Info interface
+func (i offlineInfo) GetType() KeyType {
+	return TypeOffline
+}
+
+// GetName implements Info interface
+func (i offlineInfo) GetName() string {
+	return i.Name
+}
+
+// GetPubKey implements Info interface
+func (i offlineInfo) GetPubKey() crypto.PubKey {
+	return i.PubKey
+}
+
+// GetAlgo implements Info interface
+func (i offlineInfo) GetAlgo() hd.PubKeyType {
+	return i.Algo
+}
+
+// GetPath implements Info interface
+func (i offlineInfo) GetPath() (*hd.BIP44Params, error) {
+	return nil, fmt.Errorf("BIP44 Paths are not available for this key")
+}
+
+type multisigPubKeyInfo struct {
+	PubKey crypto.PubKey `json:"pubkey"`
+	Weight uint          `json:"weight"`
+}
+
+// multiInfo is the public information about a multisig key
+type multiInfo struct {
+	Name      string               `json:"name"`
+	PubKey    crypto.PubKey        `json:"pubkey"`
+	Threshold uint                 `json:"threshold"`
+	PubKeys   []multisigPubKeyInfo `json:"pubkeys"`
+}
+
+// GetType implements Info interface
+func (i multiInfo) GetType() KeyType {
+	return TypeMulti
+}
+
+// GetName implements Info interface
+func (i multiInfo) GetName() string {
+	return i.Name
+}
+
+// GetPubKey implements Info interface
+func (i multiInfo) GetPubKey() crypto.PubKey {
+	return i.PubKey
+}
+
+// GetAlgo implements Info interface
+func (i multiInfo) GetAlgo() hd.PubKeyType {
+	return hd.MultiType
+}
+
+// UnpackInterfaces unpacks the public keys of the members nested in the multisig public key
+func (i multiInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return codectypes.UnpackInterfaces(i.PubKey, unpacker)
+}
+
+// GetPath implements Info interface
+func (i multiInfo) GetPath() (*hd.BIP44Params, error) {
+	return nil, fmt.Errorf("BIP44 Paths are not available for this key")
 }
 
 // encoding info
@@ -102,6 +227,9 @@ func marshalInfo(i Info) []byte {
 
 // decoding info
 func unmarshalInfo(bz []byte) (info Info, err error) {
-	err = cdc.UnmarshalBinaryLengthPrefixed(bz, &info)
+	if err = cdc.UnmarshalBinaryLengthPrefixed(bz, &info); err != nil {
+		return
+	}
+	err = codectypes.UnpackInterfaces(info, codectypes.AminoUnpacker{Cdc: cdc.Amino})
 	return
 }
@@ -20,6 +20,7 @@ type (
 	StatusClient  = tmclient.StatusClient
 	NetworkClient = tmclient.NetworkClient
 	Header        = tmtypes.Header
+	SignedHeader  = tmtypes.SignedHeader
 	Pair          = kv.Pair
 
 	TmPubKey = crypto.PubKey